@@ -0,0 +1,137 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// readCache de-duplicates identical GET requests, such as many resources and
+// data sources reading the same project during a refresh. Concurrent
+// requests for the same path share a single API call, and successful
+// responses are reused until they expire. Responses are cached decoded, so
+// bodies are never buffered. Any write clears the cache, so the
+// provider never reads stale data after its own changes.
+type readCache struct {
+	ttl time.Duration
+
+	mu         sync.Mutex
+	generation uint64
+	entries    map[string]*readCacheEntry
+}
+
+// readCacheEntry is a cached or in-flight decoded GET response.
+type readCacheEntry struct {
+	done    chan struct{}
+	value   any
+	err     error
+	expires time.Time
+}
+
+func newReadCache(ttl time.Duration) *readCache {
+	return &readCache{
+		ttl:     ttl,
+		entries: make(map[string]*readCacheEntry),
+	}
+}
+
+// get returns the response for path, calling fetch unless a cached or
+// in-flight response exists. Failed responses are shared with concurrent
+// callers but not cached.
+func (c *readCache) get(path string, fetch func() (any, error)) (any, error) {
+	c.mu.Lock()
+	if entry, ok := c.entries[path]; ok && (entry.expires.IsZero() || time.Now().Before(entry.expires)) {
+		c.mu.Unlock()
+		<-entry.done
+		return entry.value, entry.err
+	}
+
+	entry := &readCacheEntry{done: make(chan struct{})}
+	c.entries[path] = entry
+	generation := c.generation
+	c.mu.Unlock()
+
+	entry.value, entry.err = fetch()
+
+	c.mu.Lock()
+	entry.expires = time.Now().Add(c.ttl)
+	// Drop responses that failed or were fetched before a write.
+	if (entry.err != nil || generation != c.generation) && c.entries[path] == entry {
+		delete(c.entries, path)
+	}
+	c.mu.Unlock()
+
+	close(entry.done)
+
+	return entry.value, entry.err
+}
+
+// clear removes all cached responses.
+func (c *readCache) clear() {
+	c.mu.Lock()
+	c.generation++
+	c.entries = make(map[string]*readCacheEntry)
+	c.mu.Unlock()
+}
+
+type skipReadCacheKey struct{}
+
+// WithoutReadCache returns a context whose requests bypass the read cache,
+// for polling until a value changes.
+func WithoutReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipReadCacheKey{}, true)
+}
+
+func skipReadCache(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipReadCacheKey{}).(bool)
+	return skip
+}
+
+// deepCopy returns a copy of v that shares no pointers, slices, or maps with
+// it. Unexported struct fields, which are never decoded, are left zero.
+func deepCopy(v reflect.Value) reflect.Value {
+	result := reflect.New(v.Type()).Elem()
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			result.Set(deepCopy(v.Elem()).Addr())
+		}
+	case reflect.Interface:
+		if !v.IsNil() {
+			result.Set(deepCopy(v.Elem()))
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if result.Field(i).CanSet() {
+				result.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+	case reflect.Slice:
+		if !v.IsNil() {
+			result.Set(reflect.MakeSlice(v.Type(), v.Len(), v.Len()))
+			for i := range v.Len() {
+				result.Index(i).Set(deepCopy(v.Index(i)))
+			}
+		}
+	case reflect.Array:
+		for i := range v.Len() {
+			result.Index(i).Set(deepCopy(v.Index(i)))
+		}
+	case reflect.Map:
+		if !v.IsNil() {
+			result.Set(reflect.MakeMapWithSize(v.Type(), v.Len()))
+			for iter := v.MapRange(); iter.Next(); {
+				result.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+			}
+		}
+	default:
+		result.Set(v)
+	}
+
+	return result
+}
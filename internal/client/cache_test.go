@@ -0,0 +1,257 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestReadCache verifies that identical GET requests are de-duplicated until
+// a write clears the cache.
+func TestReadCache(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		switch {
+		case r.URL.Path == "/v1/projects/proj_missing":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data": {"id": "proj_123", "name": "test"}}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		ttl      time.Duration
+		calls    func(c *Client)
+		expected int64
+	}{
+		{
+			name: "disabled",
+			calls: func(c *Client) {
+				_, _ = c.GetProject(ctx, "proj_123")
+				_, _ = c.GetProject(ctx, "proj_123")
+			},
+			expected: 2,
+		},
+		{
+			name: "identical reads",
+			ttl:  time.Minute,
+			calls: func(c *Client) {
+				_, _ = c.GetProject(ctx, "proj_123")
+				_, _ = c.GetProject(ctx, "proj_123")
+			},
+			expected: 1,
+		},
+		{
+			name: "concurrent reads",
+			ttl:  time.Minute,
+			calls: func(c *Client) {
+				var wg sync.WaitGroup
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						_, _ = c.GetProject(ctx, "proj_123")
+					}()
+				}
+				wg.Wait()
+				_, _ = c.GetProject(ctx, "proj_123")
+			},
+			expected: 1,
+		},
+		{
+			name: "different paths",
+			ttl:  time.Minute,
+			calls: func(c *Client) {
+				_, _ = c.GetProject(ctx, "proj_123")
+				_, _ = c.GetProject(ctx, "proj_456")
+			},
+			expected: 2,
+		},
+		{
+			name: "write clears cache",
+			ttl:  time.Minute,
+			calls: func(c *Client) {
+				_, _ = c.GetProject(ctx, "proj_123")
+				_ = c.DeleteProject(ctx, "proj_456")
+				_, _ = c.GetProject(ctx, "proj_123")
+			},
+			expected: 3,
+		},
+		{
+			name: "errors are not cached",
+			ttl:  time.Minute,
+			calls: func(c *Client) {
+				_, _ = c.GetProject(ctx, "proj_missing")
+				_, _ = c.GetProject(ctx, "proj_missing")
+			},
+			expected: 2,
+		},
+		{
+			name: "bypassed",
+			ttl:  time.Minute,
+			calls: func(c *Client) {
+				_, _ = c.GetProject(ctx, "proj_123")
+				_, _ = c.GetProject(WithoutReadCache(ctx), "proj_123")
+			},
+			expected: 2,
+		},
+		{
+			name: "expired",
+			ttl:  time.Nanosecond,
+			calls: func(c *Client) {
+				_, _ = c.GetProject(ctx, "proj_123")
+				time.Sleep(time.Millisecond)
+				_, _ = c.GetProject(ctx, "proj_123")
+			},
+			expected: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests.Store(0)
+
+			c := NewClient(Config{
+				ServiceToken: "test-token",
+				BaseURL:      server.URL,
+				ReadCacheTTL: tt.ttl,
+			})
+			tt.calls(c)
+
+			if got := requests.Load(); got != tt.expected {
+				t.Errorf("expected %d requests, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestReadCacheDecoded verifies that large list responses are decoded while
+// they are read and cached as values rather than response bodies, and that
+// callers sharing them cannot change each other's results.
+func TestReadCacheDecoded(t *testing.T) {
+	const count = 10000
+
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		connections := make([]Connection, count)
+		for i := range connections {
+			connections[i] = Connection{ID: fmt.Sprintf("con_%d", i), Name: strings.Repeat("a", 64)}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ListConnectionsResponse{Data: connections})
+	}))
+	defer server.Close()
+
+	c := NewClient(Config{
+		ServiceToken: "test-token",
+		BaseURL:      server.URL,
+		ReadCacheTTL: time.Minute,
+	})
+	ctx := context.Background()
+
+	first, err := c.ListConnections(ctx, "db_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first) != count {
+		t.Fatalf("expected %d connections, got %d", count, len(first))
+	}
+
+	entry, ok := c.readCache.entries["/v1/databases/db_123/connections"]
+	if !ok {
+		t.Fatal("expected the response to be cached")
+	}
+	if _, ok := entry.value.(*ListConnectionsResponse); !ok {
+		t.Fatalf("expected a decoded response to be cached, got %T", entry.value)
+	}
+
+	first[0].Name = "changed"
+
+	second, err := c.ListConnections(ctx, "db_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second[0].Name == "changed" {
+		t.Error("expected callers to receive their own copy of a cached response")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+// TestReadCacheCopies verifies that changing a cached response, including
+// its slices and the values its pointers refer to, does not change the
+// response returned by later reads.
+func TestReadCacheCopies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/v1/databases/db_123/backups" {
+			_, _ = w.Write([]byte(`{"data": [{"id": "bkp_1", "status": "completed"}, {"id": "bkp_2", "status": "completed"}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data": {"id": "db_123", "region": {"id": "us-east-1"}, "apiKeys": [{"id": "key_1"}]}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(Config{
+		ServiceToken: "test-token",
+		BaseURL:      server.URL,
+		ReadCacheTTL: time.Minute,
+	})
+	ctx := context.Background()
+
+	backups, err := c.ListBackups(ctx, "db_123", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	backups.Data[0].Status = "changed"
+	slices.Reverse(backups.Data)
+
+	database, err := c.GetDatabase(ctx, "db_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	database.Region.ID = "changed"
+	database.APIKeys[0].ID = "changed"
+
+	backups, err = c.ListBackups(ctx, "db_123", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if backups.Data[0].ID != "bkp_1" || backups.Data[0].Status != "completed" {
+		t.Errorf("expected the cached backups to be unchanged, got %+v", backups.Data)
+	}
+
+	database, err = c.GetDatabase(ctx, "db_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if database.Region.ID != "us-east-1" || database.APIKeys[0].ID != "key_1" {
+		t.Errorf("expected the cached database to be unchanged, got region %q and API key %q", database.Region.ID, database.APIKeys[0].ID)
+	}
+}
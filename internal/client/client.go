@@ -8,10 +8,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
@@ -20,6 +27,13 @@ const (
 
 	// DefaultTimeout is the default HTTP client timeout.
 	DefaultTimeout = 30 * time.Second
+
+	// DefaultMaxResponseBodySize is the default limit on the size of a
+	// response body.
+	DefaultMaxResponseBodySize = 64 << 20
+
+	// maxLoggedBodySize limits how much of a response body is logged.
+	maxLoggedBodySize = 64 << 10
 )
 
 // Client is an HTTP client for the Prisma Postgres API.
@@ -28,6 +42,11 @@ type Client struct {
 	serviceToken string
 	userAgent    string
 	baseURL      string
+	debugCurl    bool
+	headers      map[string]string
+	readCache    *readCache
+
+	maxResponseBodySize int64
 }
 
 // Config holds configuration for creating a new Client.
@@ -36,6 +55,23 @@ type Config struct {
 	UserAgent    string
 	BaseURL      string
 	HTTPClient   *http.Client
+
+	// DebugCurl logs a cURL command equivalent to each request, with the
+	// service token replaced by an environment variable reference.
+	DebugCurl bool
+
+	// Headers are added to every request, such as headers required by an
+	// API gateway. They cannot replace the headers set by the client.
+	Headers map[string]string
+
+	// ReadCacheTTL enables de-duplication of identical GET requests and sets
+	// how long successful responses are reused. Zero disables the cache.
+	ReadCacheTTL time.Duration
+
+	// MaxResponseBodySize limits the size of a response body, so a very
+	// large listing fails instead of exhausting memory. Zero uses
+	// DefaultMaxResponseBodySize.
+	MaxResponseBodySize int64
 }
 
 // NewClient creates a new Prisma API client.
@@ -57,30 +93,134 @@ func NewClient(cfg Config) *Client {
 		userAgent = "terraform-provider-prisma-postgres/1.0"
 	}
 
-	return &Client{
-		httpClient:   httpClient,
-		serviceToken: cfg.ServiceToken,
-		userAgent:    userAgent,
-		baseURL:      baseURL,
+	maxResponseBodySize := cfg.MaxResponseBodySize
+	if maxResponseBodySize <= 0 {
+		maxResponseBodySize = DefaultMaxResponseBodySize
 	}
+
+	c := &Client{
+		httpClient:          httpClient,
+		serviceToken:        cfg.ServiceToken,
+		userAgent:           userAgent,
+		baseURL:             baseURL,
+		debugCurl:           cfg.DebugCurl,
+		headers:             maps.Clone(cfg.Headers),
+		maxResponseBodySize: maxResponseBodySize,
+	}
+
+	if cfg.ReadCacheTTL > 0 {
+		c.readCache = newReadCache(cfg.ReadCacheTTL)
+	}
+
+	return c
 }
 
 // APIError represents an error response from the Prisma API.
 type APIError struct {
 	StatusCode int
+	Code       string
 	Message    string
+	RequestID  string
 	Body       string
 }
 
 func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("Prisma API error (status %d, code %s): %s", e.StatusCode, e.Code, e.Message)
+	}
+
 	return fmt.Sprintf("Prisma API error (status %d): %s", e.StatusCode, e.Message)
 }
 
-// doRequest performs an HTTP request to the Prisma API.
+// errorResponse is the error body returned by the Prisma API.
+type errorResponse struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// newAPIError builds an APIError from an error response, falling back to
+// the HTTP status text when the body is not a Prisma API error.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    http.StatusText(resp.StatusCode),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Body:       string(body),
+	}
+
+	var errResp errorResponse
+	if err := json.Unmarshal(body, &errResp); err == nil {
+		apiErr.Code = errResp.Error.Code
+		if errResp.Error.Message != "" {
+			apiErr.Message = errResp.Error.Message
+		}
+	}
+
+	return apiErr
+}
+
+// doRequest performs an HTTP request to the Prisma API and decodes the
+// response into result. GET requests are served from the read cache when it
+// is enabled, and any other request clears it.
 func (c *Client) doRequest(ctx context.Context, method, path string, body, result interface{}) error {
+	if c.readCache == nil || method != http.MethodGet || result == nil || skipReadCache(ctx) {
+		err := c.send(ctx, method, path, body, func(r io.Reader) error {
+			return decodeResponse(r, result)
+		})
+
+		if c.readCache != nil && method != http.MethodGet {
+			c.readCache.clear()
+		}
+
+		return err
+	}
+
+	// Cached responses are kept decoded rather than as bytes, so large list
+	// pages are decoded while they are read. Every caller sharing a response
+	// receives a deep copy, so changing it cannot affect later reads.
+	resultType := reflect.TypeOf(result).Elem()
+	value, err := c.readCache.get(path, func() (any, error) {
+		decoded := reflect.New(resultType).Interface()
+		err := c.send(ctx, method, path, body, func(r io.Reader) error {
+			return decodeResponse(r, decoded)
+		})
+
+		return decoded, err
+	})
+	if err != nil {
+		return err
+	}
+
+	reflect.ValueOf(result).Elem().Set(deepCopy(reflect.ValueOf(value).Elem()))
+
+	return nil
+}
+
+// decodeResponse decodes a JSON response body into result while it is read,
+// without buffering it. An empty body leaves result unchanged.
+func decodeResponse(r io.Reader, result interface{}) error {
+	if result == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(r).Decode(result); err != nil && err != io.EOF {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// send performs an HTTP request to the Prisma API and passes the response
+// body to read, or returns an APIError for error responses. Response bodies
+// larger than the configured maximum fail instead of exhausting memory.
+func (c *Client) send(ctx context.Context, method, path string, body interface{}, read func(io.Reader) error) error {
 	var bodyReader io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
@@ -92,39 +232,79 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body, resul
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+
 	req.Header.Set("Authorization", "Bearer "+c.serviceToken)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", c.userAgent)
 
+	ctx = c.MaskLogValues(ctx)
+
+	tflog.Debug(ctx, "Sending Prisma API request", map[string]any{
+		"method": method,
+		"path":   path,
+	})
+
+	if c.debugCurl {
+		tflog.Debug(ctx, "Prisma API request as cURL command", map[string]any{
+			"curl": curlCommand(req, jsonBody),
+		})
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
+	// Keep the start of the body for logging while it is read.
+	logged := &truncatedBuffer{max: maxLoggedBodySize}
+	respBody := io.TeeReader(http.MaxBytesReader(nil, resp.Body, c.maxResponseBodySize), logged)
 
 	if resp.StatusCode >= 400 {
-		return &APIError{
-			StatusCode: resp.StatusCode,
-			Message:    http.StatusText(resp.StatusCode),
-			Body:       string(respBody),
+		errBody, err := io.ReadAll(respBody)
+		c.logResponse(ctx, method, path, resp, logged)
+		if err != nil {
+			return c.readError(fmt.Errorf("failed to read response body: %w", err))
 		}
+
+		return newAPIError(resp, errBody)
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
-		}
+	err = read(respBody)
+	c.logResponse(ctx, method, path, resp, logged)
+	if err != nil {
+		return c.readError(err)
 	}
 
 	return nil
 }
 
+// readError replaces the error from reading a response body that is too
+// large with one naming the limit.
+func (c *Client) readError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return fmt.Errorf("response body exceeds the maximum size of %d bytes", c.maxResponseBodySize)
+	}
+
+	return err
+}
+
+// logResponse logs a response with the start of its body.
+func (c *Client) logResponse(ctx context.Context, method, path string, resp *http.Response, body *truncatedBuffer) {
+	tflog.Trace(ctx, "Received Prisma API response", map[string]any{
+		"method":      method,
+		"path":        path,
+		"status_code": resp.StatusCode,
+		"request_id":  resp.Header.Get("X-Request-Id"),
+		"body":        body.String(),
+	})
+}
+
 // ProjectRef is a minimal project reference in API responses.
 type ProjectRef struct {
 	ID   string `json:"id"`
@@ -193,6 +373,33 @@ func (c *Client) DeleteProject(ctx context.Context, id string) error {
 	return c.doRequest(ctx, http.MethodDelete, "/v1/projects/"+id, nil, nil)
 }
 
+// ListProjectsResponse is the response from listing projects.
+type ListProjectsResponse struct {
+	Data       []Project   `json:"data"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// ListProjects lists all projects in the workspace of the service token.
+func (c *Client) ListProjects(ctx context.Context) ([]Project, error) {
+	var projects []Project
+	path := "/v1/projects"
+
+	for {
+		var resp ListProjectsResponse
+		if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, resp.Data...)
+
+		if resp.Pagination == nil || !resp.Pagination.HasMore || resp.Pagination.NextCursor == "" {
+			return projects, nil
+		}
+
+		path = "/v1/projects?cursor=" + url.QueryEscape(resp.Pagination.NextCursor)
+	}
+}
+
 // DirectConnection represents direct PostgreSQL connection details.
 type DirectConnection struct {
 	Host string `json:"host"`
@@ -230,7 +437,8 @@ type Database struct {
 	DirectConnection *DirectConnection `json:"directConnection,omitempty"` // Only on create
 }
 
-// Region represents a Prisma Postgres region.
+// Region represents a Prisma Postgres or Prisma Accelerate region.
+// Accelerate regions have no status.
 type Region struct {
 	ID     string `json:"id"`
 	Type   string `json:"type,omitempty"` // "region"
@@ -238,11 +446,19 @@ type Region struct {
 	Status string `json:"status,omitempty"` // "available" or "unavailable"
 }
 
+// DatabaseSource identifies the database, and optionally the backup, a new
+// database is restored from.
+type DatabaseSource struct {
+	ID       string `json:"id"`
+	BackupID string `json:"backupId,omitempty"`
+}
+
 // CreateDatabaseRequest is the request body for creating a database.
 type CreateDatabaseRequest struct {
-	Name      string `json:"name"`
-	Region    string `json:"region,omitempty"`
-	IsDefault bool   `json:"isDefault"`
+	Name         string          `json:"name"`
+	Region       string          `json:"region,omitempty"`
+	IsDefault    bool            `json:"isDefault"`
+	FromDatabase *DatabaseSource `json:"fromDatabase,omitempty"`
 }
 
 // CreateDatabaseResponse is the response from creating a database.
@@ -271,6 +487,27 @@ func (c *Client) CreateDatabase(ctx context.Context, projectID, name, region str
 	return &resp.Data, nil
 }
 
+// RestoreDatabase creates a new database in a project from a backup of an
+// existing database. backupID is optional.
+func (c *Client) RestoreDatabase(ctx context.Context, projectID, name, region, sourceDatabaseID, backupID string) (*Database, error) {
+	req := CreateDatabaseRequest{
+		Name:      name,
+		Region:    region,
+		IsDefault: false,
+		FromDatabase: &DatabaseSource{
+			ID:       sourceDatabaseID,
+			BackupID: backupID,
+		},
+	}
+
+	var resp CreateDatabaseResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/projects/"+projectID+"/databases", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data, nil
+}
+
 // GetDatabase retrieves a database by ID.
 func (c *Client) GetDatabase(ctx context.Context, id string) (*Database, error) {
 	var resp GetDatabaseResponse
@@ -286,6 +523,121 @@ func (c *Client) DeleteDatabase(ctx context.Context, id string) error {
 	return c.doRequest(ctx, http.MethodDelete, "/v1/databases/"+id, nil, nil)
 }
 
+// ListDatabasesResponse is the response from listing databases.
+type ListDatabasesResponse struct {
+	Data       []Database  `json:"data"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// ListDatabases lists all databases in a project.
+func (c *Client) ListDatabases(ctx context.Context, projectID string) ([]Database, error) {
+	var databases []Database
+	path := "/v1/projects/" + projectID + "/databases"
+
+	for {
+		var resp ListDatabasesResponse
+		if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, err
+		}
+
+		databases = append(databases, resp.Data...)
+
+		if resp.Pagination == nil || !resp.Pagination.HasMore || resp.Pagination.NextCursor == "" {
+			return databases, nil
+		}
+
+		path = "/v1/projects/" + projectID + "/databases?cursor=" + url.QueryEscape(resp.Pagination.NextCursor)
+	}
+}
+
+// Backup represents a database backup.
+type Backup struct {
+	ID         string  `json:"id"`
+	Type       string  `json:"type"`       // Always "backup"
+	BackupType string  `json:"backupType"` // full, incremental
+	CreatedAt  string  `json:"createdAt"`
+	Size       float64 `json:"size,omitempty"`
+	Status     string  `json:"status"` // running, completed, failed, unknown
+}
+
+// BackupsMeta holds the backup policy returned alongside the backups.
+type BackupsMeta struct {
+	BackupRetentionDays int64 `json:"backupRetentionDays"`
+}
+
+// ListBackupsResponse is the response from listing database backups.
+type ListBackupsResponse struct {
+	Data       []Backup    `json:"data"`
+	Meta       BackupsMeta `json:"meta"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// ListBackups lists the most recent backups of a database. A zero limit uses
+// the API default.
+func (c *Client) ListBackups(ctx context.Context, databaseID string, limit int64) (*ListBackupsResponse, error) {
+	path := "/v1/databases/" + databaseID + "/backups"
+	if limit > 0 {
+		path += "?limit=" + strconv.FormatInt(limit, 10)
+	}
+
+	var resp ListBackupsResponse
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UsagePeriod is the time window a usage report covers.
+type UsagePeriod struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// UsageMetric is a single usage measurement.
+type UsageMetric struct {
+	Used float64 `json:"used"`
+	Unit string  `json:"unit"` // "ops" for operations, "GiB" for storage
+}
+
+// UsageMetrics holds the metrics reported for a database.
+type UsageMetrics struct {
+	Operations UsageMetric `json:"operations"`
+	Storage    UsageMetric `json:"storage"`
+}
+
+// DatabaseUsage is the response from getting database usage.
+type DatabaseUsage struct {
+	Period      UsagePeriod  `json:"period"`
+	Metrics     UsageMetrics `json:"metrics"`
+	GeneratedAt string       `json:"generatedAt"`
+}
+
+// GetDatabaseUsage retrieves usage metrics for a database. Empty startDate or
+// endDate values fall back to the API defaults (start of the current month and
+// the current date).
+func (c *Client) GetDatabaseUsage(ctx context.Context, id, startDate, endDate string) (*DatabaseUsage, error) {
+	query := url.Values{}
+	if startDate != "" {
+		query.Set("startDate", startDate)
+	}
+	if endDate != "" {
+		query.Set("endDate", endDate)
+	}
+
+	path := "/v1/databases/" + id + "/usage"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var resp DatabaseUsage
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 // Connection represents a Prisma Postgres database connection/API key.
 type Connection struct {
 	ID               string       `json:"id"`
@@ -337,12 +689,23 @@ func (c *Client) CreateConnection(ctx context.Context, databaseID, name string)
 
 // ListConnections lists all connections for a database.
 func (c *Client) ListConnections(ctx context.Context, databaseID string) ([]Connection, error) {
-	var resp ListConnectionsResponse
-	if err := c.doRequest(ctx, http.MethodGet, "/v1/databases/"+databaseID+"/connections", nil, &resp); err != nil {
-		return nil, err
-	}
+	var connections []Connection
+	path := "/v1/databases/" + databaseID + "/connections"
 
-	return resp.Data, nil
+	for {
+		var resp ListConnectionsResponse
+		if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, err
+		}
+
+		connections = append(connections, resp.Data...)
+
+		if resp.Pagination == nil || !resp.Pagination.HasMore || resp.Pagination.NextCursor == "" {
+			return connections, nil
+		}
+
+		path = "/v1/databases/" + databaseID + "/connections?cursor=" + url.QueryEscape(resp.Pagination.NextCursor)
+	}
 }
 
 // DeleteConnection deletes a connection by ID.
@@ -350,6 +713,41 @@ func (c *Client) DeleteConnection(ctx context.Context, id string) error {
 	return c.doRequest(ctx, http.MethodDelete, "/v1/connections/"+id, nil, nil)
 }
 
+// Workspace represents a Prisma workspace.
+type Workspace struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"` // Always "workspace"
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// ListWorkspacesResponse is the response from listing workspaces.
+type ListWorkspacesResponse struct {
+	Data       []Workspace `json:"data"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// ListWorkspaces lists all workspaces the service token can access.
+func (c *Client) ListWorkspaces(ctx context.Context) ([]Workspace, error) {
+	var workspaces []Workspace
+	path := "/v1/workspaces"
+
+	for {
+		var resp ListWorkspacesResponse
+		if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, err
+		}
+
+		workspaces = append(workspaces, resp.Data...)
+
+		if resp.Pagination == nil || !resp.Pagination.HasMore || resp.Pagination.NextCursor == "" {
+			return workspaces, nil
+		}
+
+		path = "/v1/workspaces?cursor=" + url.QueryEscape(resp.Pagination.NextCursor)
+	}
+}
+
 // ListRegionsResponse is the response from listing regions.
 type ListRegionsResponse struct {
 	Data []Region `json:"data"`
@@ -364,3 +762,13 @@ func (c *Client) ListRegions(ctx context.Context) ([]Region, error) {
 
 	return resp.Data, nil
 }
+
+// ListAccelerateRegions lists all regions served by Prisma Accelerate.
+func (c *Client) ListAccelerateRegions(ctx context.Context) ([]Region, error) {
+	var resp ListRegionsResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/v1/regions/accelerate", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 // newTestClient creates a client configured to use the test server.
@@ -72,6 +73,39 @@ func TestAPIError(t *testing.T) {
 	if err.Error() != expected {
 		t.Errorf("expected error %q, got %q", expected, err.Error())
 	}
+
+	err.Code = "NOT_FOUND"
+	expected = "Prisma API error (status 404, code NOT_FOUND): Not Found"
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+}
+
+// TestAPIErrorResponse verifies error response parsing.
+func TestAPIErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req_123")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error": {"code": "FORBIDDEN", "message": "Token lacks write scope"}}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	_, err := client.GetProject(context.Background(), "proj_123")
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.Code != "FORBIDDEN" {
+		t.Errorf("expected Code 'FORBIDDEN', got %q", apiErr.Code)
+	}
+	if apiErr.Message != "Token lacks write scope" {
+		t.Errorf("expected Message 'Token lacks write scope', got %q", apiErr.Message)
+	}
+	if apiErr.RequestID != "req_123" {
+		t.Errorf("expected RequestID 'req_123', got %q", apiErr.RequestID)
+	}
 }
 
 // TestCreateProject verifies project creation.
@@ -294,6 +328,59 @@ func TestCreateDatabase(t *testing.T) {
 	})
 }
 
+// TestRestoreDatabase verifies creating a database from a backup.
+func TestRestoreDatabase(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/projects/proj_123/databases" {
+				t.Errorf("expected /v1/projects/proj_123/databases, got %s", r.URL.Path)
+			}
+
+			var req CreateDatabaseRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			if req.FromDatabase == nil {
+				t.Fatal("expected fromDatabase to be set")
+			}
+			if req.FromDatabase.ID != "db_456" {
+				t.Errorf("expected fromDatabase.id 'db_456', got %q", req.FromDatabase.ID)
+			}
+			if req.FromDatabase.BackupID != "bkp_1" {
+				t.Errorf("expected fromDatabase.backupId 'bkp_1', got %q", req.FromDatabase.BackupID)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(CreateDatabaseResponse{
+				Data: Database{
+					ID:        "db_789",
+					Type:      "database",
+					Name:      req.Name,
+					Status:    "recovering",
+					CreatedAt: "2025-01-07T00:00:00Z",
+				},
+			})
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+		db, err := client.RestoreDatabase(context.Background(), "proj_123", "restored", "us-east-1", "db_456", "bkp_1")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.ID != "db_789" {
+			t.Errorf("expected ID 'db_789', got %q", db.ID)
+		}
+		if db.Status != "recovering" {
+			t.Errorf("expected status 'recovering', got %q", db.Status)
+		}
+	})
+}
+
 // TestGetDatabase verifies database retrieval.
 func TestGetDatabase(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
@@ -367,6 +454,90 @@ func TestDeleteDatabase(t *testing.T) {
 	})
 }
 
+// TestListBackups verifies listing database backups.
+func TestListBackups(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("expected GET, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/databases/db_456/backups" {
+				t.Errorf("expected /v1/databases/db_456/backups, got %s", r.URL.Path)
+			}
+			if got := r.URL.Query().Get("limit"); got != "10" {
+				t.Errorf("expected limit '10', got %q", got)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(ListBackupsResponse{
+				Data: []Backup{
+					{ID: "bkp_1", Type: "backup", BackupType: "full", CreatedAt: "2025-01-07T00:00:00Z", Size: 1024, Status: "completed"},
+				},
+				Meta:       BackupsMeta{BackupRetentionDays: 7},
+				Pagination: &Pagination{HasMore: false},
+			})
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+		backups, err := client.ListBackups(context.Background(), "db_456", 10)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if backups.Meta.BackupRetentionDays != 7 {
+			t.Errorf("expected retention of 7 days, got %d", backups.Meta.BackupRetentionDays)
+		}
+		if len(backups.Data) != 1 || backups.Data[0].ID != "bkp_1" {
+			t.Errorf("expected backup 'bkp_1', got %+v", backups.Data)
+		}
+	})
+}
+
+// TestGetDatabaseUsage verifies retrieving database usage metrics.
+func TestGetDatabaseUsage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("expected GET, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/databases/db_456/usage" {
+				t.Errorf("expected /v1/databases/db_456/usage, got %s", r.URL.Path)
+			}
+			if got := r.URL.Query().Get("startDate"); got != "2025-01-01T00:00:00Z" {
+				t.Errorf("expected startDate '2025-01-01T00:00:00Z', got %q", got)
+			}
+			if r.URL.Query().Has("endDate") {
+				t.Error("expected endDate to be omitted")
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(DatabaseUsage{
+				Period: UsagePeriod{Start: "2025-01-01T00:00:00Z", End: "2025-01-07T00:00:00Z"},
+				Metrics: UsageMetrics{
+					Operations: UsageMetric{Used: 1200, Unit: "ops"},
+					Storage:    UsageMetric{Used: 0.5, Unit: "GiB"},
+				},
+				GeneratedAt: "2025-01-07T00:00:00Z",
+			})
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+		usage, err := client.GetDatabaseUsage(context.Background(), "db_456", "2025-01-01T00:00:00Z", "")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if usage.Metrics.Operations.Used != 1200 {
+			t.Errorf("expected 1200 operations, got %v", usage.Metrics.Operations.Used)
+		}
+		if usage.Metrics.Storage.Used != 0.5 {
+			t.Errorf("expected 0.5 GiB storage, got %v", usage.Metrics.Storage.Used)
+		}
+	})
+}
+
 // TestCreateConnection verifies connection creation.
 func TestCreateConnection(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
@@ -464,6 +635,46 @@ func TestListConnections(t *testing.T) {
 			t.Errorf("expected first connection ID 'conn_789', got %q", conns[0].ID)
 		}
 	})
+	t.Run("pages", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/databases/db_456/connections" {
+				t.Errorf("expected /v1/databases/db_456/connections, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			if r.URL.Query().Get("cursor") == "" {
+				_ = json.NewEncoder(w).Encode(ListConnectionsResponse{
+					Data:       []Connection{{ID: "conn_789", Type: "connection", Name: "first"}},
+					Pagination: &Pagination{NextCursor: "page2", HasMore: true},
+				})
+				return
+			}
+
+			if cursor := r.URL.Query().Get("cursor"); cursor != "page2" {
+				t.Errorf("expected cursor 'page2', got %q", cursor)
+			}
+
+			_ = json.NewEncoder(w).Encode(ListConnectionsResponse{
+				Data:       []Connection{{ID: "conn_790", Type: "connection", Name: "second"}},
+				Pagination: &Pagination{HasMore: false},
+			})
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+		conns, err := client.ListConnections(context.Background(), "db_456")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(conns) != 2 {
+			t.Fatalf("expected 2 connections, got %d", len(conns))
+		}
+		if conns[1].ID != "conn_790" {
+			t.Errorf("expected second connection ID 'conn_790', got %q", conns[1].ID)
+		}
+	})
 }
 
 // TestDeleteConnection verifies connection deletion.
@@ -489,6 +700,131 @@ func TestDeleteConnection(t *testing.T) {
 	})
 }
 
+// TestListWorkspaces verifies listing workspaces across pages.
+func TestListWorkspaces(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("expected GET, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/workspaces" {
+				t.Errorf("expected /v1/workspaces, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			if r.URL.Query().Get("cursor") == "" {
+				_ = json.NewEncoder(w).Encode(ListWorkspacesResponse{
+					Data: []Workspace{
+						{ID: "wksp_1", Type: "workspace", Name: "first", CreatedAt: "2025-01-07T00:00:00Z"},
+					},
+					Pagination: &Pagination{NextCursor: "page2", HasMore: true},
+				})
+				return
+			}
+
+			if cursor := r.URL.Query().Get("cursor"); cursor != "page2" {
+				t.Errorf("expected cursor 'page2', got %q", cursor)
+			}
+
+			_ = json.NewEncoder(w).Encode(ListWorkspacesResponse{
+				Data: []Workspace{
+					{ID: "wksp_2", Type: "workspace", Name: "second", CreatedAt: "2025-01-07T00:00:00Z"},
+				},
+				Pagination: &Pagination{HasMore: false},
+			})
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+		workspaces, err := client.ListWorkspaces(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(workspaces) != 2 {
+			t.Fatalf("expected 2 workspaces, got %d", len(workspaces))
+		}
+		if workspaces[1].ID != "wksp_2" {
+			t.Errorf("expected second workspace ID 'wksp_2', got %q", workspaces[1].ID)
+		}
+	})
+}
+
+// TestListProjects verifies listing projects across pages.
+func TestListProjects(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/projects" {
+				t.Errorf("expected /v1/projects, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			if r.URL.Query().Get("cursor") == "" {
+				_ = json.NewEncoder(w).Encode(ListProjectsResponse{
+					Data:       []Project{{ID: "proj_1", Type: "project", Name: "first"}},
+					Pagination: &Pagination{NextCursor: "page2", HasMore: true},
+				})
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(ListProjectsResponse{
+				Data:       []Project{{ID: "proj_2", Type: "project", Name: "second"}},
+				Pagination: &Pagination{HasMore: false},
+			})
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+		projects, err := client.ListProjects(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(projects) != 2 {
+			t.Fatalf("expected 2 projects, got %d", len(projects))
+		}
+		if projects[1].ID != "proj_2" {
+			t.Errorf("expected second project ID 'proj_2', got %q", projects[1].ID)
+		}
+	})
+}
+
+// TestListDatabases verifies listing databases in a project.
+func TestListDatabases(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/projects/proj_123/databases" {
+				t.Errorf("expected /v1/projects/proj_123/databases, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(ListDatabasesResponse{
+				Data: []Database{
+					{ID: "db_1", Type: "database", Name: "first", Status: "ready"},
+					{ID: "db_2", Type: "database", Name: "second", Status: "provisioning"},
+				},
+				Pagination: &Pagination{HasMore: false},
+			})
+		}))
+		defer server.Close()
+
+		client := newTestClient(server)
+		databases, err := client.ListDatabases(context.Background(), "proj_123")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(databases) != 2 {
+			t.Fatalf("expected 2 databases, got %d", len(databases))
+		}
+		if databases[1].Status != "provisioning" {
+			t.Errorf("expected second database status 'provisioning', got %q", databases[1].Status)
+		}
+	})
+}
+
 // TestListRegions verifies listing regions.
 func TestListRegions(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
@@ -526,6 +862,40 @@ func TestListRegions(t *testing.T) {
 	})
 }
 
+// TestListAccelerateRegions verifies listing Accelerate regions.
+func TestListAccelerateRegions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/regions/accelerate" {
+			t.Errorf("expected /v1/regions/accelerate, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ListRegionsResponse{
+			Data: []Region{
+				{ID: "us-east-1", Type: "region", Name: "US East (N. Virginia)"},
+				{ID: "eu-central-1", Type: "region", Name: "Europe (Frankfurt)"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	regions, err := client.ListAccelerateRegions(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(regions) != 2 {
+		t.Errorf("expected 2 regions, got %d", len(regions))
+	}
+	if regions[1].ID != "eu-central-1" {
+		t.Errorf("expected second region ID 'eu-central-1', got %q", regions[1].ID)
+	}
+}
+
 // TestInvalidJSONResponse verifies handling of malformed API responses.
 func TestInvalidJSONResponse(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -545,7 +915,65 @@ func TestInvalidJSONResponse(t *testing.T) {
 	}
 }
 
-// TestRequestHeaders verifies all required headers are set.
+// TestMaxResponseBodySize verifies that responses larger than the
+// configured limit fail, with and without the read cache.
+func TestMaxResponseBodySize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ListConnectionsResponse{Data: []Connection{
+			{ID: "con_1", Name: strings.Repeat("a", 512)},
+		}})
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		maxSize      int64
+		readCacheTTL time.Duration
+		expected     string
+	}{
+		{
+			name:     "within limit",
+			maxSize:  1024,
+			expected: "",
+		},
+		{
+			name:     "exceeds limit",
+			maxSize:  256,
+			expected: "response body exceeds the maximum size of 256 bytes",
+		},
+		{
+			name:         "exceeds limit with read cache",
+			maxSize:      256,
+			readCacheTTL: time.Minute,
+			expected:     "response body exceeds the maximum size of 256 bytes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(Config{
+				ServiceToken:        "test-token",
+				BaseURL:             server.URL,
+				HTTPClient:          server.Client(),
+				ReadCacheTTL:        tt.readCacheTTL,
+				MaxResponseBodySize: tt.maxSize,
+			})
+
+			_, err := client.ListConnections(context.Background(), "db_123")
+
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestRequestHeaders verifies all required and custom headers are set.
 func TestRequestHeaders(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Verify all expected headers.
@@ -561,6 +989,9 @@ func TestRequestHeaders(t *testing.T) {
 		if ua := r.Header.Get("User-Agent"); ua != "test-agent/1.0" {
 			t.Errorf("expected User-Agent 'test-agent/1.0', got %q", ua)
 		}
+		if clientID := r.Header.Get("X-Client-Id"); clientID != "client-1" {
+			t.Errorf("expected X-Client-Id 'client-1', got %q", clientID)
+		}
 
 		w.Header().Set("Content-Type", "application/json")
 		_ = json.NewEncoder(w).Encode(ListRegionsResponse{Data: []Region{}})
@@ -572,6 +1003,11 @@ func TestRequestHeaders(t *testing.T) {
 		UserAgent:    "test-agent/1.0",
 		BaseURL:      server.URL,
 		HTTPClient:   server.Client(),
+		Headers: map[string]string{
+			"X-Client-Id": "client-1",
+			// Custom headers cannot replace the service token.
+			"Authorization": "Bearer other-token",
+		},
 	})
 
 	_, err := client.ListRegions(context.Background())
@@ -0,0 +1,167 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &AccelerateRegionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &AccelerateRegionsDataSource{}
+)
+
+// AccelerateRegionsDataSource defines the data source implementation.
+type AccelerateRegionsDataSource struct {
+	client *client.Client
+}
+
+// AccelerateRegionsDataSourceModel describes the data source data model.
+type AccelerateRegionsDataSourceModel struct {
+	IDs     types.Set               `tfsdk:"ids"`
+	Regions []AccelerateRegionModel `tfsdk:"regions"`
+}
+
+// AccelerateRegionModel describes a single Accelerate region.
+type AccelerateRegionModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// NewAccelerateRegionsDataSource creates a new Accelerate regions data source.
+func NewAccelerateRegionsDataSource() datasource.DataSource {
+	return &AccelerateRegionsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *AccelerateRegionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_accelerate_regions"
+}
+
+// Schema defines the schema for the data source.
+func (d *AccelerateRegionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the regions served by Prisma Accelerate.",
+		MarkdownDescription: `
+Lists the regions served by Prisma Accelerate, the connection pooler and cache
+behind the ` + "`connection_string`" + ` of databases and connections.
+
+The API does not publish hostnames per region. Accelerate connection strings
+use a single global host, which is part of ` + "`connection_string`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_accelerate_regions" "all" {}
+
+output "accelerate_regions" {
+  value = data.prisma-postgres_accelerate_regions.all.regions[*].id
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.SetAttribute{
+				Description: "Only return regions with these identifiers.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"regions": schema.ListNestedAttribute{
+				Description: "List of regions matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The region identifier (e.g., us-east-1).",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The region name.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *AccelerateRegionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *AccelerateRegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state AccelerateRegionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	if !state.IDs.IsNull() {
+		resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Reading Prisma Accelerate regions", map[string]any{
+		"ids": ids,
+	})
+
+	regions, err := d.client.ListAccelerateRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading Accelerate regions", err),
+			"Could not read Accelerate regions: "+errorDetail(err),
+		)
+		return
+	}
+
+	// The API does not support filtering, so filters are applied here.
+	state.Regions = []AccelerateRegionModel{}
+	for _, region := range regions {
+		if ids != nil && !slices.Contains(ids, region.ID) {
+			continue
+		}
+
+		state.Regions = append(state.Regions, AccelerateRegionModel{
+			ID:   types.StringValue(region.ID),
+			Name: types.StringValue(region.Name),
+		})
+	}
+
+	tflog.Trace(ctx, "Read Prisma Accelerate regions", map[string]any{
+		"count": len(state.Regions),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
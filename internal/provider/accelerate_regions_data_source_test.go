@@ -0,0 +1,40 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccelerateRegionsDataSource tests the Accelerate regions data source.
+func TestAccelerateRegionsDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "prisma-postgres_accelerate_regions" "test" {}
+
+data "prisma-postgres_accelerate_regions" "ids" {
+  ids = ["eu-central-1"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_accelerate_regions.test", "regions.#", "4"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_accelerate_regions.test", "regions.0.id", "us-east-1"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_accelerate_regions.ids", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_accelerate_regions.ids", "regions.0.name", "Europe (Frankfurt)"),
+				),
+			},
+		},
+	})
+}
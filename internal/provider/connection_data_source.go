@@ -0,0 +1,221 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ConnectionDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConnectionDataSource{}
+)
+
+// ConnectionDataSource defines the data source implementation.
+type ConnectionDataSource struct {
+	client *client.Client
+}
+
+// ConnectionDataSourceModel describes the data source data model.
+type ConnectionDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	DatabaseID          types.String `tfsdk:"database_id"`
+	Name                types.String `tfsdk:"name"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	DatabaseDisplayName types.String `tfsdk:"database_display_name"`
+}
+
+// NewConnectionDataSource creates a new connection data source.
+func NewConnectionDataSource() datasource.DataSource {
+	return &ConnectionDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *ConnectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection"
+}
+
+// Schema defines the schema for the data source.
+func (d *ConnectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the metadata of an existing Prisma Postgres database connection (API key) by ID or name.",
+		MarkdownDescription: `
+Looks up the metadata of an existing Prisma Postgres database connection (API key)
+by ID or by name, such as a key created by an integration. Looking up a name
+that several connections of the database share is an error.
+
+Only non-sensitive metadata is returned. Credentials are only available when a
+connection is created and are never exposed by this data source.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_connection" "api" {
+  database_id = "db_abc123"
+  id          = "con_abc123"
+}
+
+output "api_key_created_at" {
+  value = data.prisma-postgres_connection.api.created_at
+}
+
+data "prisma-postgres_connection" "vercel" {
+  database_id = "db_abc123"
+  name        = "vercel-integration"
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the connection. Exactly one of id and name must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				Description: "The ID of the database the connection belongs to.",
+				Required:    true,
+				Validators: []validator.String{
+					databaseIDValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the connection. Exactly one of id and name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the connection was created.",
+				Computed:    true,
+			},
+			"database_display_name": schema.StringAttribute{
+				Description: "The display name of the Prisma Postgres database the connection belongs to, not the PostgreSQL database name.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ConnectionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state ConnectionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma connection", map[string]any{
+		"id":          state.ID.ValueString(),
+		"name":        state.Name.ValueString(),
+		"database_id": state.DatabaseID.ValueString(),
+	})
+
+	// The API doesn't have a GET /connections/{id} endpoint,
+	// so we list all connections for the database and find ours
+	connections, err := d.client.ListConnections(ctx, state.DatabaseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading connection", err),
+			"Could not list connections for database "+state.DatabaseID.ValueString()+": "+errorDetail(err),
+		)
+		return
+	}
+
+	var connection *client.Connection
+	if state.ID.IsNull() {
+		// The API trims names, so the configured name is trimmed as well.
+		name := strings.TrimSpace(state.Name.ValueString())
+
+		for i := range connections {
+			if connections[i].Name != name {
+				continue
+			}
+
+			if connection != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("name"),
+					"Multiple connections found",
+					fmt.Sprintf("Database %q has more than one connection named %q (%s and %s). Set id to select one.",
+						state.DatabaseID.ValueString(), name, connection.ID, connections[i].ID),
+				)
+				return
+			}
+
+			connection = &connections[i]
+		}
+
+		if connection == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Connection not found",
+				fmt.Sprintf("Database %q has no connection named %q.", state.DatabaseID.ValueString(), name),
+			)
+			return
+		}
+	} else {
+		for i := range connections {
+			if connections[i].ID == state.ID.ValueString() {
+				connection = &connections[i]
+				break
+			}
+		}
+
+		if connection == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Connection not found",
+				fmt.Sprintf("Connection %q does not exist in database %q.", state.ID.ValueString(), state.DatabaseID.ValueString()),
+			)
+			return
+		}
+
+		state.Name = types.StringValue(connection.Name)
+	}
+
+	state.ID = types.StringValue(connection.ID)
+	state.CreatedAt = types.StringValue(connection.CreatedAt)
+	state.DatabaseDisplayName = types.StringNull()
+
+	if connection.Database != nil {
+		state.DatabaseDisplayName = types.StringValue(connection.Database.Name)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
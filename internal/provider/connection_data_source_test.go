@@ -0,0 +1,133 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestConnectionDataSource tests looking up connection metadata by ID.
+func TestConnectionDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConnectionDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.prisma-postgres_connection.test", "id",
+						"prisma-postgres_connection.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.prisma-postgres_connection.test", "name", "test-connection"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_connection.test", "database_display_name", "test-database"),
+					resource.TestCheckResourceAttrSet("data.prisma-postgres_connection.test", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testConnectionDataSourceConfig() string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+  region     = "us-east-1"
+}
+
+resource "prisma-postgres_connection" "test" {
+  database_id = prisma-postgres_database.test.id
+  name        = "test-connection"
+}
+
+data "prisma-postgres_connection" "test" {
+  database_id = prisma-postgres_database.test.id
+  id          = prisma-postgres_connection.test.id
+}
+`
+}
+
+// TestConnectionDataSourceByName tests looking up connection metadata by
+// name, and that ambiguous or unknown names fail.
+func TestConnectionDataSourceByName(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConnectionDataSourceByNameConfig("test-connection"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.prisma-postgres_connection.test", "id",
+						"prisma-postgres_connection.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.prisma-postgres_connection.test", "database_display_name", "test-database"),
+					resource.TestCheckResourceAttrSet("data.prisma-postgres_connection.test", "created_at"),
+				),
+			},
+			{
+				PreConfig: func() {
+					mock.AddConnection("db_test1", "con_vercel1", "vercel-integration", "2025-01-01T00:00:00Z")
+				},
+				Config: testConnectionDataSourceByNameConfig("vercel-integration"),
+				Check:  resource.TestCheckResourceAttr("data.prisma-postgres_connection.test", "id", "con_vercel1"),
+			},
+			{
+				PreConfig: func() {
+					mock.AddConnection("db_test1", "con_vercel2", "vercel-integration", "2025-01-02T00:00:00Z")
+				},
+				Config:      testConnectionDataSourceByNameConfig("vercel-integration"),
+				ExpectError: regexp.MustCompile(`Multiple connections found`),
+			},
+			{
+				Config:      testConnectionDataSourceByNameConfig("missing"),
+				ExpectError: regexp.MustCompile(`Connection not found`),
+			},
+		},
+	})
+}
+
+func testConnectionDataSourceByNameConfig(name string) string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+  region     = "us-east-1"
+}
+
+resource "prisma-postgres_connection" "test" {
+  database_id = prisma-postgres_database.test.id
+  name        = "test-connection"
+}
+
+data "prisma-postgres_connection" "test" {
+  database_id = prisma-postgres_database.test.id
+  name        = "` + name + `"
+
+  depends_on = [prisma-postgres_connection.test]
+}
+`
+}
@@ -0,0 +1,195 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/prismatest"
+)
+
+// TestConnectionSetResource tests that the connection set creates added
+// names and deletes removed names in place, keeping unchanged connections.
+func TestConnectionSetResource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	apiIDs := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConnectionSetResourceConfig(`["api", "worker"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("prisma-postgres_connection_set.test", "id", "prisma-postgres_database.test", "id"),
+					resource.TestCheckResourceAttr("prisma-postgres_connection_set.test", "connections.%", "2"),
+					resource.TestCheckResourceAttrSet("prisma-postgres_connection_set.test", "connections.api.connection_string"),
+					resource.TestCheckResourceAttr("prisma-postgres_connection_set.test", "connections.worker.api_key", "conn_test_key"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					apiIDs.AddStateValue("prisma-postgres_connection_set.test", tfjsonpath.New("connections").AtMapKey("api").AtMapKey("id")),
+				},
+			},
+			{
+				Config: testConnectionSetResourceConfig(`["api", "analytics"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_connection_set.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("prisma-postgres_connection_set.test",
+							tfjsonpath.New("connections").AtMapKey("api").AtMapKey("id"), knownvalue.NotNull()),
+						plancheck.ExpectUnknownValue("prisma-postgres_connection_set.test",
+							tfjsonpath.New("connections").AtMapKey("analytics")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prisma-postgres_connection_set.test", "connections.%", "2"),
+					resource.TestCheckResourceAttrSet("prisma-postgres_connection_set.test", "connections.analytics.id"),
+					resource.TestCheckNoResourceAttr("prisma-postgres_connection_set.test", "connections.worker.id"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					apiIDs.AddStateValue("prisma-postgres_connection_set.test", tfjsonpath.New("connections").AtMapKey("api").AtMapKey("id")),
+				},
+			},
+		},
+	})
+}
+
+// TestConnectionSetResourceExactSet tests that the connection set replaces
+// existing connections with a configured name and deletes any other
+// connection of the database, including ones added after it was created.
+func TestConnectionSetResourceExactSet(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	databaseConfig := `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+  region     = "us-east-1"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+			},
+			{
+				PreConfig: func() {
+					mock.AddConnection("db_test1", "con_existing_api", "api", "2024-01-01T00:00:00Z")
+					mock.AddConnection("db_test1", "con_existing_extra", "extra", "2024-01-01T00:00:00Z")
+				},
+				Config: testConnectionSetResourceConfig(`["api", "worker"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prisma-postgres_connection_set.test", "connections.%", "2"),
+					resource.TestCheckResourceAttrSet("prisma-postgres_connection_set.test", "connections.api.connection_string"),
+					testCheckConnectionIDs(mock, 2, "con_existing_api", "con_existing_extra"),
+				),
+			},
+			{
+				PreConfig: func() {
+					mock.AddConnection("db_test1", "con_external", "external", "2024-01-01T00:00:00Z")
+				},
+				Config: testConnectionSetResourceConfig(`["api", "worker"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_connection_set.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prisma-postgres_connection_set.test", "names.#", "2"),
+					resource.TestCheckNoResourceAttr("prisma-postgres_connection_set.test", "connections.external.id"),
+					testCheckConnectionIDs(mock, 2, "con_external"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckConnectionIDs checks that the mock server has count connections,
+// none of which has one of the deleted IDs.
+func testCheckConnectionIDs(mock *prismatest.Server, count int, deleted ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		ids := mock.ConnectionIDs()
+		if len(ids) != count {
+			return fmt.Errorf("expected %d connections, got %v", count, ids)
+		}
+
+		for _, id := range deleted {
+			if slices.Contains(ids, id) {
+				return fmt.Errorf("expected connection %s to be deleted", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testConnectionSetResourceConfig(names string) string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+  region     = "us-east-1"
+}
+
+resource "prisma-postgres_connection_set" "test" {
+  database_id = prisma-postgres_database.test.id
+  names       = ` + names + `
+}
+`
+}
+
+// TestConnectionSetResourceUnknownName tests that names only known after
+// apply can be added to an existing connection set.
+func TestConnectionSetResourceUnknownName(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConnectionSetResourceConfig(`["api"]`),
+			},
+			{
+				Config: testConnectionSetResourceConfig(`["api", "key-${prisma-postgres_project.other.id}"]`) + `
+resource "prisma-postgres_project" "other" {
+  name = "other"
+}
+`,
+				Check: resource.TestCheckResourceAttr("prisma-postgres_connection_set.test", "connections.%", "2"),
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ConnectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConnectionsDataSource{}
+)
+
+// ConnectionsDataSource defines the data source implementation.
+type ConnectionsDataSource struct {
+	client *client.Client
+}
+
+// ConnectionsDataSourceModel describes the data source data model.
+type ConnectionsDataSourceModel struct {
+	DatabaseID  types.String                 `tfsdk:"database_id"`
+	Name        types.String                 `tfsdk:"name"`
+	Connections []ConnectionsConnectionModel `tfsdk:"connections"`
+}
+
+// ConnectionsConnectionModel describes a single connection.
+type ConnectionsConnectionModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// NewConnectionsDataSource creates a new connections data source.
+func NewConnectionsDataSource() datasource.DataSource {
+	return &ConnectionsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *ConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connections"
+}
+
+// Schema defines the schema for the data source.
+func (d *ConnectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the connections (API keys) of a Prisma Postgres database.",
+		MarkdownDescription: `
+Lists the metadata of the connections (API keys) of a Prisma Postgres database.
+
+Only non-sensitive metadata is returned. Credentials are only available when a
+connection is created and are never exposed by this data source.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_connections" "all" {
+  database_id = "db_abc123"
+}
+
+output "connection_names" {
+  value = data.prisma-postgres_connections.all.connections[*].name
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "The ID of the database to list connections of.",
+				Required:    true,
+				Validators: []validator.String{
+					databaseIDValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return connections with this name.",
+				Optional:    true,
+			},
+			"connections": schema.ListNestedAttribute{
+				Description: "List of connections matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the connection.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the connection.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the connection was created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ConnectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state ConnectionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma connections", map[string]any{
+		"database_id": state.DatabaseID.ValueString(),
+		"name":        state.Name.ValueString(),
+	})
+
+	connections, err := d.client.ListConnections(ctx, state.DatabaseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading connections", err),
+			"Could not list connections for database "+state.DatabaseID.ValueString()+": "+errorDetail(err),
+		)
+		return
+	}
+
+	// The API does not support filtering, so filters are applied here.
+	state.Connections = []ConnectionsConnectionModel{}
+	for _, connection := range connections {
+		if !state.Name.IsNull() && connection.Name != state.Name.ValueString() {
+			continue
+		}
+
+		state.Connections = append(state.Connections, ConnectionsConnectionModel{
+			ID:        types.StringValue(connection.ID),
+			Name:      types.StringValue(connection.Name),
+			CreatedAt: types.StringValue(connection.CreatedAt),
+		})
+	}
+
+	tflog.Trace(ctx, "Read Prisma connections", map[string]any{
+		"count": len(state.Connections),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestConnectionsDataSource tests listing connections, optionally by name.
+func TestConnectionsDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConnectionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_connections.all", "connections.#", "2"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_connections.api", "connections.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.prisma-postgres_connections.api", "connections.0.id",
+						"prisma-postgres_connection.api", "id",
+					),
+					resource.TestCheckResourceAttr("data.prisma-postgres_connections.api", "connections.0.name", "api"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_connections.api", "connections.0.created_at", "2025-01-07T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testConnectionsDataSourceConfig() string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+  region     = "us-east-1"
+}
+
+resource "prisma-postgres_connection" "api" {
+  database_id = prisma-postgres_database.test.id
+  name        = "api"
+}
+
+resource "prisma-postgres_connection" "worker" {
+  database_id = prisma-postgres_database.test.id
+  name        = "worker"
+}
+
+data "prisma-postgres_connections" "all" {
+  database_id = prisma-postgres_database.test.id
+
+  depends_on = [prisma-postgres_connection.api, prisma-postgres_connection.worker]
+}
+
+data "prisma-postgres_connections" "api" {
+  database_id = prisma-postgres_database.test.id
+  name        = "api"
+
+  depends_on = [prisma-postgres_connection.api, prisma-postgres_connection.worker]
+}
+`
+}
@@ -0,0 +1,177 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// directPort is the port direct PostgreSQL connections listen on.
+	directPort = 5432
+
+	// directDatabaseName is the database name used by direct PostgreSQL connections.
+	directDatabaseName = "postgres"
+
+	// directSSLMode is the libpq sslmode for direct PostgreSQL connections,
+	// which only accept TLS.
+	directSSLMode = "require"
+
+	// redactedValue replaces secrets in redacted URLs.
+	redactedValue = "****"
+)
+
+// buildDirectURL returns the direct PostgreSQL connection URL for the given
+// credentials, or an empty string when no host is available.
+func buildDirectURL(user, password, host string) string {
+	if host == "" {
+		return ""
+	}
+
+	u := url.URL{
+		Scheme: "postgresql",
+		User:   url.UserPassword(user, password),
+		Host:   net.JoinHostPort(host, strconv.Itoa(directPort)),
+		Path:   "/" + directDatabaseName,
+	}
+
+	return u.String()
+}
+
+// buildLibpqDSN returns the libpq keyword/value connection string for the
+// given credentials, or an empty string when no host is available.
+func buildLibpqDSN(user, password, host string) string {
+	if host == "" {
+		return ""
+	}
+
+	params := []struct{ key, value string }{
+		{"host", host},
+		{"port", strconv.Itoa(directPort)},
+		{"user", user},
+		{"password", password},
+		{"dbname", directDatabaseName},
+		{"sslmode", directSSLMode},
+	}
+
+	parts := make([]string, len(params))
+	for i, param := range params {
+		parts[i] = param.key + "=" + quoteLibpqValue(param.value)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// quoteLibpqValue quotes a libpq keyword/value parameter value when it is
+// empty or contains whitespace, quotes, or backslashes.
+func quoteLibpqValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t\n\r'\\") {
+		return value
+	}
+
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// parseAPIKey extracts the api_key query parameter from a Prisma Accelerate
+// connection string, or returns an empty string when none is present.
+func parseAPIKey(connectionString string) string {
+	u, err := url.Parse(connectionString)
+	if err != nil {
+		return ""
+	}
+
+	return u.Query().Get("api_key")
+}
+
+// redactURL masks the password and the api_key query parameter of a
+// connection URL, leaving the rest of the URL intact.
+func redactURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+
+	// url.UserPassword would percent-encode the mask, so the user info is
+	// added back after the URL has been rendered.
+	userInfo := ""
+	if u.User != nil {
+		userInfo = u.User.String()
+		if _, ok := u.User.Password(); ok {
+			userInfo = url.User(u.User.Username()).String() + ":" + redactedValue
+		}
+		u.User = nil
+	}
+
+	// Rewrite the raw query rather than re-encoding it so that parameter
+	// order and the mask are preserved.
+	if u.RawQuery != "" {
+		params := strings.Split(u.RawQuery, "&")
+		for i, param := range params {
+			key, _, _ := strings.Cut(param, "=")
+			if name, err := url.QueryUnescape(key); err == nil && name == "api_key" {
+				params[i] = key + "=" + redactedValue
+			}
+		}
+		u.RawQuery = strings.Join(params, "&")
+	}
+
+	if userInfo == "" {
+		return u.String(), nil
+	}
+
+	return strings.Replace(u.String(), "//", "//"+userInfo+"@", 1), nil
+}
+
+// setURLParams sets query parameters on a connection URL. Existing
+// parameters keep their position and encoding unless they are overridden,
+// and new parameters are appended in key order.
+func setURLParams(raw string, params map[string]string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+
+	encode := func(key, value string) string {
+		return url.QueryEscape(key) + "=" + url.QueryEscape(value)
+	}
+
+	var query []string
+	set := make(map[string]bool, len(params))
+	if u.RawQuery != "" {
+		for _, param := range strings.Split(u.RawQuery, "&") {
+			key, _, _ := strings.Cut(param, "=")
+			if name, err := url.QueryUnescape(key); err == nil {
+				if value, ok := params[name]; ok {
+					// Drop repeated occurrences of overridden parameters.
+					if set[name] {
+						continue
+					}
+					set[name] = true
+					param = encode(name, value)
+				}
+			}
+			query = append(query, param)
+		}
+	}
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if !set[key] {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		query = append(query, encode(key, params[key]))
+	}
+
+	u.RawQuery = strings.Join(query, "&")
+
+	return u.String(), nil
+}
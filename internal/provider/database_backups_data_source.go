@@ -0,0 +1,195 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DatabaseBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DatabaseBackupsDataSource{}
+)
+
+// DatabaseBackupsDataSource defines the data source implementation.
+type DatabaseBackupsDataSource struct {
+	client *client.Client
+}
+
+// DatabaseBackupsDataSourceModel describes the data source data model.
+type DatabaseBackupsDataSourceModel struct {
+	DatabaseID    types.String  `tfsdk:"database_id"`
+	Limit         types.Int64   `tfsdk:"limit"`
+	RetentionDays types.Int64   `tfsdk:"retention_days"`
+	Backups       []BackupModel `tfsdk:"backups"`
+}
+
+// BackupModel describes a single backup.
+type BackupModel struct {
+	ID         types.String  `tfsdk:"id"`
+	BackupType types.String  `tfsdk:"backup_type"`
+	CreatedAt  types.String  `tfsdk:"created_at"`
+	Size       types.Float64 `tfsdk:"size"`
+	Status     types.String  `tfsdk:"status"`
+}
+
+// NewDatabaseBackupsDataSource creates a new database backups data source.
+func NewDatabaseBackupsDataSource() datasource.DataSource {
+	return &DatabaseBackupsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DatabaseBackupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_backups"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabaseBackupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the automated backups of a Prisma Postgres database and its backup retention.",
+		MarkdownDescription: `
+Lists the automated backups of a Prisma Postgres database and its backup retention.
+
+Backups are taken automatically by Prisma Postgres and their schedule and
+retention cannot be configured through the API. Use this data source to assert
+the retention policy or to look up backup IDs. Backups are not available for
+remote databases.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_database_backups" "production" {
+  database_id = prisma-postgres_database.production.id
+}
+
+check "backup_retention" {
+  assert {
+    condition     = data.prisma-postgres_database_backups.production.retention_days >= 7
+    error_message = "Backups must be retained for at least 7 days."
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "The ID of the database.",
+				Required:    true,
+				Validators: []validator.String{
+					databaseIDValidator(),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of backups to return (1-100). Defaults to 25.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"retention_days": schema.Int64Attribute{
+				Description: "The number of days backups are retained.",
+				Computed:    true,
+			},
+			"backups": schema.ListNestedAttribute{
+				Description: "List of backups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the backup.",
+							Computed:    true,
+						},
+						"backup_type": schema.StringAttribute{
+							Description: "The backup type (full or incremental).",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the backup was created.",
+							Computed:    true,
+						},
+						"size": schema.Float64Attribute{
+							Description: "The size of the backup.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The backup status (running, completed, failed, or unknown).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DatabaseBackupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DatabaseBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state DatabaseBackupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma database backups", map[string]any{
+		"database_id": state.DatabaseID.ValueString(),
+	})
+
+	backups, err := d.client.ListBackups(ctx, state.DatabaseID.ValueString(), state.Limit.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading database backups", err),
+			"Could not list backups for database ID "+state.DatabaseID.ValueString()+": "+errorDetail(err),
+		)
+		return
+	}
+
+	state.RetentionDays = types.Int64Value(backups.Meta.BackupRetentionDays)
+	state.Backups = []BackupModel{}
+	for _, backup := range backups.Data {
+		state.Backups = append(state.Backups, BackupModel{
+			ID:         types.StringValue(backup.ID),
+			BackupType: types.StringValue(backup.BackupType),
+			CreatedAt:  types.StringValue(backup.CreatedAt),
+			Size:       types.Float64Value(backup.Size),
+			Status:     types.StringValue(backup.Status),
+		})
+	}
+
+	tflog.Trace(ctx, "Read Prisma database backups", map[string]any{
+		"count": len(state.Backups),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
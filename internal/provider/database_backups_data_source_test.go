@@ -0,0 +1,49 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestDatabaseBackupsDataSource tests listing database backups.
+func TestDatabaseBackupsDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+  region     = "us-east-1"
+}
+
+data "prisma-postgres_database_backups" "test" {
+  database_id = prisma-postgres_database.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_backups.test", "retention_days", "7"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_backups.test", "backups.#", "1"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_backups.test", "backups.0.id", "bkp_test1"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_backups.test", "backups.0.backup_type", "full"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_backups.test", "backups.0.status", "completed"),
+				),
+			},
+		},
+	})
+}
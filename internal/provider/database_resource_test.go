@@ -4,17 +4,28 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/prismatest"
 )
 
 // TestDatabaseResource tests the database resource lifecycle.
 func TestDatabaseResource(t *testing.T) {
 	mock := newMockAPIServer()
 	defer mock.Close()
-	mock.SetupProjectHandlers()
-	mock.SetupDatabaseHandlers()
 
 	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
 	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
@@ -31,8 +42,92 @@ func TestDatabaseResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("prisma-postgres_database.test", "status"),
 					resource.TestCheckResourceAttrSet("prisma-postgres_database.test", "connection_string"),
 					resource.TestCheckResourceAttrSet("prisma-postgres_database.test", "direct_url"),
+					resource.TestCheckResourceAttrSet("prisma-postgres_database.test", "libpq_dsn"),
+					resource.TestCheckNoResourceAttr("prisma-postgres_database.test", "storage_used_gib"),
+					func(*terraform.State) error {
+						if got := mock.Requests("GET", "/v1/databases/db_test1/usage"); got != 0 {
+							return fmt.Errorf("expected usage not to be read, got %d requests", got)
+						}
+						return nil
+					},
 				),
 			},
+			{
+				ResourceName:      "prisma-postgres_database.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Credentials are only returned on create.
+				ImportStateVerifyIgnore: []string{
+					"connection_string",
+					"direct_url",
+					"direct_host",
+					"direct_user",
+					"direct_password",
+					"libpq_dsn",
+				},
+			},
+			{
+				ResourceName:      "prisma-postgres_database.test",
+				ImportState:       true,
+				ImportStateIdFunc: testDatabaseImportStateIDFunc("prisma-postgres_database.test"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"connection_string",
+					"direct_url",
+					"direct_host",
+					"direct_user",
+					"direct_password",
+					"libpq_dsn",
+				},
+			},
+			{
+				ResourceName: "prisma-postgres_database.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "proj_other," + s.RootModule().Resources["prisma-postgres_database.test"].Primary.ID, nil
+				},
+				ExpectError: regexp.MustCompile(`Database belongs to a different project`),
+			},
+		},
+	})
+}
+
+func testDatabaseImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["project_id"] + "," + rs.Primary.ID, nil
+	}
+}
+
+// TestDatabaseResourceDefaultRegion tests that omitting region uses the API
+// default and does not plan a replacement afterwards.
+func TestDatabaseResourceDefaultRegion(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+}
+`,
+				Check: resource.TestCheckResourceAttr("prisma-postgres_database.test", "region", "us-east-1"),
+			},
 		},
 	})
 }
@@ -50,3 +145,676 @@ resource "prisma-postgres_database" "test" {
 }
 `
 }
+
+// TestDatabaseResourceStorageUsed tests that track_storage_used reads
+// storage_used_gib and can be toggled in place.
+func TestDatabaseResourceStorageUsed(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabaseResourceTrackStorageConfig(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("storage_used_gib"), knownvalue.Float64Exact(0.5)),
+				},
+			},
+			{
+				Config: testDatabaseResourceTrackStorageConfig(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_database.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("storage_used_gib"), knownvalue.Null()),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("storage_used_gib"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testDatabaseResourceTrackStorageConfig(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_database.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("prisma-postgres_database.test", tfjsonpath.New("storage_used_gib")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("storage_used_gib"), knownvalue.Float64Exact(0.5)),
+				},
+			},
+		},
+	})
+}
+
+// TestDatabaseResourceStorageUsedProvisioning tests that the usage metrics
+// of a database are not read until it is ready.
+func TestDatabaseResourceStorageUsedProvisioning(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+	mock.SetDatabaseCreateStatus(prismatest.StatusProvisioning)
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabaseResourceTrackStorageConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prisma-postgres_database.test", "status", "provisioning"),
+					resource.TestCheckNoResourceAttr("prisma-postgres_database.test", "storage_used_gib"),
+					func(*terraform.State) error {
+						if got := mock.Requests("GET", "/v1/databases/db_test1/usage"); got != 0 {
+							return fmt.Errorf("expected usage not to be read, got %d requests", got)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prisma-postgres_database.test", "status", "ready"),
+					resource.TestCheckResourceAttr("prisma-postgres_database.test", "storage_used_gib", "0.5"),
+				),
+			},
+		},
+	})
+}
+
+func testDatabaseResourceTrackStorageConfig(track bool) string {
+	return fmt.Sprintf(`
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id         = prisma-postgres_project.test.id
+  name               = "test-database"
+  region             = "us-east-1"
+  track_storage_used = %t
+}
+`, track)
+}
+
+// TestDatabaseResourceUsageUnavailable tests that failing to read the usage
+// metrics leaves storage_used_gib null instead of failing the database.
+func TestDatabaseResourceUsageUnavailable(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	mock.Handle("GET", "/v1/databases/db_test1/usage", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"error": {"code": "INTERNAL_SERVER_ERROR", "message": "Error occurred while fetching metrics"}}`))
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabaseResourceTrackStorageConfig(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("id"), knownvalue.StringExact("db_test1")),
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("storage_used_gib"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+// TestDatabaseResourceIdentity tests that the database exposes its identity
+// and can be imported by it.
+func TestDatabaseResourceIdentity(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabaseResourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("prisma-postgres_database.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("prisma-postgres_database.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "prisma-postgres_database.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+// TestDatabaseResourceRestore tests creating a database from a backup and
+// waiting for the restore to finish.
+func TestDatabaseResourceRestore(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	interval := databasePollInterval
+	databasePollInterval = 10 * time.Millisecond
+	defer func() { databasePollInterval = interval }()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id     = prisma-postgres_project.test.id
+  name           = "test-database"
+  from_backup_id = "bkp_test1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute "from_database_id" must be specified`),
+			},
+			{
+				Config: `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "source" {
+  project_id = prisma-postgres_project.test.id
+  name       = "source-database"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id       = prisma-postgres_project.test.id
+  name             = "test-database"
+  from_database_id = prisma-postgres_database.source.id
+  from_backup_id   = "bkp_test1"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prisma-postgres_database.test", "status", "ready"),
+					resource.TestCheckResourceAttrPair("prisma-postgres_database.test", "from_database_id", "prisma-postgres_database.source", "id"),
+					resource.TestCheckResourceAttr("prisma-postgres_database.test", "from_backup_id", "bkp_test1"),
+					resource.TestCheckResourceAttrSet("prisma-postgres_database.test", "connection_string"),
+				),
+			},
+		},
+	})
+}
+
+// TestDatabaseResourceRegionEquivalence tests that other spellings of the
+// same region update the database in place instead of replacing it.
+func TestDatabaseResourceRegionEquivalence(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabaseResourceRegionConfig("Paris"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("region"), knownvalue.StringExact("Paris")),
+				},
+			},
+			{
+				Config: testDatabaseResourceRegionConfig("EU-WEST-3"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_database.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("connection_string"), knownvalue.NotNull()),
+					},
+				},
+			},
+			{
+				Config: testDatabaseResourceRegionConfig("us-west-1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_database.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testDatabaseResourceRegionConfig(region string) string {
+	return fmt.Sprintf(`
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+  region     = %q
+}
+`, region)
+}
+
+// TestDatabaseResourcePreventReplacement tests that prevent_replacement
+// fails plans that would replace the database and can be toggled in place.
+func TestDatabaseResourcePreventReplacement(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabaseResourcePreventReplacementConfig("test-database", true),
+			},
+			{
+				Config:      testDatabaseResourcePreventReplacementConfig("renamed-database", true),
+				ExpectError: regexp.MustCompile(`Database replacement prevented`),
+			},
+			{
+				Config: testDatabaseResourcePreventReplacementConfig("test-database", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_database.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("connection_string"), knownvalue.NotNull()),
+					},
+				},
+			},
+			{
+				Config: testDatabaseResourcePreventReplacementConfig("renamed-database", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_database.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("prisma-postgres_database.test", "name", "renamed-database"),
+			},
+		},
+	})
+}
+
+func testDatabaseResourcePreventReplacementConfig(name string, preventReplacement bool) string {
+	return fmt.Sprintf(`
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id          = prisma-postgres_project.test.id
+  name                = %q
+  region              = "us-east-1"
+  prevent_replacement = %t
+}
+`, name, preventReplacement)
+}
+
+// TestDatabaseResourceMinimalState tests that minimal_state keeps direct
+// credentials out of state on create and when enabled later.
+func TestDatabaseResourceMinimalState(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabaseResourceMinimalStateConfig("minimal", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.minimal", tfjsonpath.New("connection_string"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("prisma-postgres_database.minimal", tfjsonpath.New("direct_url"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("prisma-postgres_database.minimal", tfjsonpath.New("direct_host"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("prisma-postgres_database.minimal", tfjsonpath.New("direct_user"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("prisma-postgres_database.minimal", tfjsonpath.New("direct_password"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("prisma-postgres_database.minimal", tfjsonpath.New("libpq_dsn"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testDatabaseResourceMinimalStateConfig("full", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.full", tfjsonpath.New("direct_url"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testDatabaseResourceMinimalStateConfig("full", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_database.full", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("prisma-postgres_database.full", tfjsonpath.New("connection_string"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("prisma-postgres_database.full", tfjsonpath.New("direct_url"), knownvalue.Null()),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.full", tfjsonpath.New("direct_password"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func testDatabaseResourceMinimalStateConfig(name string, minimalState bool) string {
+	return fmt.Sprintf(`
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" %[1]q {
+  project_id    = prisma-postgres_project.test.id
+  name          = %[1]q
+  region        = "us-east-1"
+  minimal_state = %[2]t
+}
+`, name, minimalState)
+}
+
+// TestDatabaseResourceCreateConnection tests that the create_connection
+// block creates a connection with the database, replaces it in place when
+// renamed, and deletes it when removed.
+func TestDatabaseResourceCreateConnection(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	connectionIDs := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testDatabaseResourceCreateConnectionConfig(`create_connection {}`),
+				ExpectError: regexp.MustCompile(`Attribute "create_connection.name" must be specified`),
+			},
+			{
+				Config: testDatabaseResourceCreateConnectionConfig(`create_connection { name = "api" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prisma-postgres_database.test", "create_connection.name", "api"),
+					resource.TestCheckResourceAttrSet("prisma-postgres_database.test", "create_connection.connection_string"),
+					resource.TestCheckResourceAttr("prisma-postgres_database.test", "create_connection.api_key", "conn_test_key"),
+					resource.TestCheckResourceAttr("prisma-postgres_database.test", "create_connection.libpq_dsn",
+						"host=accelerate.prisma-data.net port=5432 user=prisma password=conn_test_password dbname=postgres sslmode=require"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					connectionIDs.AddStateValue("prisma-postgres_database.test", tfjsonpath.New("create_connection").AtMapKey("id")),
+				},
+			},
+			{
+				Config: testDatabaseResourceCreateConnectionConfig(`create_connection { name = "worker" }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_database.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("connection_string"), knownvalue.NotNull()),
+						plancheck.ExpectUnknownValue("prisma-postgres_database.test", tfjsonpath.New("create_connection").AtMapKey("connection_string")),
+					},
+				},
+				Check: func(*terraform.State) error {
+					if ids := mock.ConnectionIDs(); len(ids) != 1 {
+						return fmt.Errorf("expected the previous connection to be deleted, got connections %v", ids)
+					}
+					return nil
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					connectionIDs.AddStateValue("prisma-postgres_database.test", tfjsonpath.New("create_connection").AtMapKey("id")),
+				},
+			},
+			{
+				Config: testDatabaseResourceCreateConnectionConfig(""),
+				Check: func(*terraform.State) error {
+					if ids := mock.ConnectionIDs(); len(ids) != 0 {
+						return fmt.Errorf("expected the connection to be deleted, got connections %v", ids)
+					}
+					return nil
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("create_connection"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func testDatabaseResourceCreateConnectionConfig(block string) string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+  region     = "us-east-1"
+
+  ` + block + `
+}
+`
+}
+
+// TestDatabaseResourceAdoptExisting tests that adopt_existing takes over a
+// database created outside of Terraform instead of creating a new one.
+func TestDatabaseResourceAdoptExisting(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	databaseIDs := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			// Stands in for a database created in the Console.
+			{
+				Config: testDatabaseResourceAdoptExistingConfig(`
+resource "prisma-postgres_database" "console" {
+  project_id = prisma-postgres_project.test.id
+  name       = "staging"
+  region     = "us-east-1"
+}
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					databaseIDs.AddStateValue("prisma-postgres_database.console", tfjsonpath.New("id")),
+				},
+			},
+			{
+				Config: testDatabaseResourceAdoptExistingConfig(`
+removed {
+  from = prisma-postgres_database.console
+
+  lifecycle {
+    destroy = false
+  }
+}
+
+resource "prisma-postgres_database" "adopted" {
+  project_id     = prisma-postgres_project.test.id
+  name           = "staging"
+  region         = "eu-west-3"
+  adopt_existing = true
+}
+`),
+				ExpectError: regexp.MustCompile(`Existing database is in a different region`),
+			},
+			{
+				Config: testDatabaseResourceAdoptExistingConfig(`
+removed {
+  from = prisma-postgres_database.console
+
+  lifecycle {
+    destroy = false
+  }
+}
+
+resource "prisma-postgres_database" "adopted" {
+  project_id     = prisma-postgres_project.test.id
+  name           = "staging"
+  region         = "US-EAST-1"
+  adopt_existing = true
+}
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					databaseIDs.AddStateValue("prisma-postgres_database.adopted", tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue("prisma-postgres_database.adopted", tfjsonpath.New("region"), knownvalue.StringExact("US-EAST-1")),
+					statecheck.ExpectKnownValue("prisma-postgres_database.adopted", tfjsonpath.New("connection_string"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func testDatabaseResourceAdoptExistingConfig(databases string) string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+` + databases
+}
+
+// TestDatabaseResourceInvalidProjectID tests that a reference to another
+// kind of object fails at plan time.
+func TestDatabaseResourceInvalidProjectID(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "prisma-postgres_database" "test" {
+  project_id = "db_test1"
+  name       = "test-database"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid project ID`),
+			},
+		},
+	})
+}
+
+// TestDatabaseResourceCreateFailure tests that a database created with
+// status failure errors and is tainted.
+func TestDatabaseResourceCreateFailure(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+	mock.SetDatabaseCreateStatus(prismatest.StatusFailure)
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testDatabaseResourceConfig(),
+				ExpectError: regexp.MustCompile(`Database provisioning failed`),
+			},
+		},
+	})
+}
+
+// TestDatabaseResourceCreateConnectionProvisioning tests that the
+// create_connection connection is created once a provisioning database is
+// ready.
+func TestDatabaseResourceCreateConnectionProvisioning(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+	mock.SetDatabaseCreateStatus(prismatest.StatusProvisioning)
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	interval := databasePollInterval
+	databasePollInterval = 10 * time.Millisecond
+	defer func() { databasePollInterval = interval }()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabaseResourceCreateConnectionConfig(`create_connection { name = "api" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prisma-postgres_database.test", "status", "ready"),
+					resource.TestCheckResourceAttrSet("prisma-postgres_database.test", "create_connection.connection_string"),
+				),
+			},
+		},
+	})
+}
+
+// TestDatabaseResourceNamePrefix tests that name_prefix generates the
+// database name and cannot be combined with adopt_existing.
+func TestDatabaseResourceNamePrefix(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testDatabaseResourceNamePrefixConfig("adopt_existing = true"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testDatabaseResourceNamePrefixConfig(""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("name"), knownvalue.StringRegexp(regexp.MustCompile(`^test-database-[a-z2-7]{8}$`))),
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("connection_string"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testDatabaseResourceNamePrefixConfig(adoptExisting string) string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id  = prisma-postgres_project.test.id
+  name_prefix = "test-database-"
+  region      = "us-east-1"
+  ` + adoptExisting + `
+}
+`
+}
@@ -0,0 +1,127 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DatabaseStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &DatabaseStatusDataSource{}
+)
+
+// DatabaseStatusDataSource defines the data source implementation.
+type DatabaseStatusDataSource struct {
+	client *client.Client
+}
+
+// DatabaseStatusDataSourceModel describes the data source data model.
+type DatabaseStatusDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Status types.String `tfsdk:"status"`
+}
+
+// NewDatabaseStatusDataSource creates a new database status data source.
+func NewDatabaseStatusDataSource() datasource.DataSource {
+	return &DatabaseStatusDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DatabaseStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabaseStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the current status of a Prisma Postgres database.",
+		MarkdownDescription: `
+Reads the current status of a Prisma Postgres database.
+
+The status is one of ` + "`provisioning`" + `, ` + "`ready`" + `, ` + "`recovering`" + ` or ` + "`failure`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+check "database_ready" {
+  data "prisma-postgres_database_status" "production" {
+    id = prisma-postgres_database.production.id
+  }
+
+  assert {
+    condition     = data.prisma-postgres_database_status.production.status == "ready"
+    error_message = "Database is not ready."
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the database.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The database status (provisioning, ready, recovering, or failure).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DatabaseStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DatabaseStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state DatabaseStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma database status", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	database, err := d.client.GetDatabase(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading database status", err),
+			"Could not read database ID "+state.ID.ValueString()+": "+errorDetail(err),
+		)
+		return
+	}
+
+	state.Status = types.StringValue(database.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DatabaseUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &DatabaseUsageDataSource{}
+)
+
+// DatabaseUsageDataSource defines the data source implementation.
+type DatabaseUsageDataSource struct {
+	client *client.Client
+}
+
+// DatabaseUsageDataSourceModel describes the data source data model.
+type DatabaseUsageDataSourceModel struct {
+	DatabaseID     types.String  `tfsdk:"database_id"`
+	StartDate      types.String  `tfsdk:"start_date"`
+	EndDate        types.String  `tfsdk:"end_date"`
+	PeriodStart    types.String  `tfsdk:"period_start"`
+	PeriodEnd      types.String  `tfsdk:"period_end"`
+	OperationsUsed types.Float64 `tfsdk:"operations_used"`
+	StorageUsedGiB types.Float64 `tfsdk:"storage_used_gib"`
+	GeneratedAt    types.String  `tfsdk:"generated_at"`
+}
+
+// NewDatabaseUsageDataSource creates a new database usage data source.
+func NewDatabaseUsageDataSource() datasource.DataSource {
+	return &DatabaseUsageDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DatabaseUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_usage"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabaseUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves usage metrics for a Prisma Postgres database.",
+		MarkdownDescription: `
+Retrieves usage metrics for a Prisma Postgres database.
+
+Metrics cover the current month unless ` + "`start_date`" + ` and ` + "`end_date`" + ` are set.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_database_usage" "production" {
+  database_id = prisma-postgres_database.production.id
+}
+
+check "storage" {
+  assert {
+    condition     = data.prisma-postgres_database_usage.production.storage_used_gib < 8
+    error_message = "Database storage is above 8 GiB."
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "The ID of the database.",
+				Required:    true,
+				Validators: []validator.String{
+					databaseIDValidator(),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				Description: "Start of the reporting window as an RFC 3339 timestamp. Defaults to the start of the current month.",
+				Optional:    true,
+			},
+			"end_date": schema.StringAttribute{
+				Description: "End of the reporting window as an RFC 3339 timestamp. Defaults to the current date.",
+				Optional:    true,
+			},
+			"period_start": schema.StringAttribute{
+				Description: "The start of the period the metrics cover.",
+				Computed:    true,
+			},
+			"period_end": schema.StringAttribute{
+				Description: "The end of the period the metrics cover.",
+				Computed:    true,
+			},
+			"operations_used": schema.Float64Attribute{
+				Description: "The number of operations performed during the period.",
+				Computed:    true,
+			},
+			"storage_used_gib": schema.Float64Attribute{
+				Description: "The storage used, in GiB.",
+				Computed:    true,
+			},
+			"generated_at": schema.StringAttribute{
+				Description: "The timestamp when the metrics were generated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DatabaseUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DatabaseUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state DatabaseUsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma database usage", map[string]any{
+		"database_id": state.DatabaseID.ValueString(),
+		"start_date":  state.StartDate.ValueString(),
+		"end_date":    state.EndDate.ValueString(),
+	})
+
+	usage, err := d.client.GetDatabaseUsage(ctx, state.DatabaseID.ValueString(), state.StartDate.ValueString(), state.EndDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading database usage", err),
+			"Could not read usage for database ID "+state.DatabaseID.ValueString()+": "+errorDetail(err),
+		)
+		return
+	}
+
+	state.PeriodStart = types.StringValue(usage.Period.Start)
+	state.PeriodEnd = types.StringValue(usage.Period.End)
+	state.OperationsUsed = types.Float64Value(usage.Metrics.Operations.Used)
+	state.StorageUsedGiB = types.Float64Value(usage.Metrics.Storage.Used)
+	state.GeneratedAt = types.StringValue(usage.GeneratedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestDatabaseUsageDataSource tests reading database usage metrics.
+func TestDatabaseUsageDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabaseUsageDataSourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.prisma-postgres_database_usage.test", "start_date"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_usage.test", "period_start", "2025-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_usage.test", "period_end", "2025-01-07T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_usage.test", "operations_used", "1200"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_usage.test", "storage_used_gib", "0.5"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_usage.test", "generated_at", "2025-01-07T00:00:00Z"),
+				),
+			},
+			{
+				Config: testDatabaseUsageDataSourceConfig(`start_date = "2024-12-01T00:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_database_usage.test", "period_start", "2024-12-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testDatabaseUsageDataSourceConfig(window string) string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = "test-database"
+  region     = "us-east-1"
+}
+
+data "prisma-postgres_database_usage" "test" {
+  database_id = prisma-postgres_database.test.id
+  ` + window + `
+}
+`
+}
@@ -0,0 +1,215 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DatabasesDataSource{}
+	_ datasource.DataSourceWithConfigure = &DatabasesDataSource{}
+)
+
+// DatabasesDataSource defines the data source implementation.
+type DatabasesDataSource struct {
+	client *client.Client
+}
+
+// DatabasesDataSourceModel describes the data source data model.
+type DatabasesDataSourceModel struct {
+	ProjectID types.String             `tfsdk:"project_id"`
+	Name      types.String             `tfsdk:"name"`
+	Region    types.String             `tfsdk:"region"`
+	Databases []DatabasesDatabaseModel `tfsdk:"databases"`
+}
+
+// DatabasesDatabaseModel describes a single database.
+type DatabasesDatabaseModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Region    types.String `tfsdk:"region"`
+	Status    types.String `tfsdk:"status"`
+	IsDefault types.Bool   `tfsdk:"is_default"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// NewDatabasesDataSource creates a new databases data source.
+func NewDatabasesDataSource() datasource.DataSource {
+	return &DatabasesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DatabasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_databases"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the databases of a Prisma Postgres project.",
+		MarkdownDescription: `
+Lists the databases of a Prisma Postgres project.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_databases" "all" {
+  project_id = "proj_abc123"
+}
+
+# Only databases in a region
+data "prisma-postgres_databases" "europe" {
+  project_id = "proj_abc123"
+  region     = "eu-west-3"
+}
+
+output "europe_database_ids" {
+  value = data.prisma-postgres_databases.europe.databases[*].id
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project to list databases of.",
+				Required:    true,
+				Validators: []validator.String{
+					projectIDValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return databases with this name.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Only return databases in this region.",
+				Optional:    true,
+			},
+			"databases": schema.ListNestedAttribute{
+				Description: "List of databases matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the database.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the database.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "The region where the database is deployed.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The database status (provisioning, ready, recovering, or failure).",
+							Computed:    true,
+						},
+						"is_default": schema.BoolAttribute{
+							Description: "Whether this is the default database of the project.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the database was created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DatabasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state DatabasesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma databases", map[string]any{
+		"project_id": state.ProjectID.ValueString(),
+		"name":       state.Name.ValueString(),
+		"region":     state.Region.ValueString(),
+	})
+
+	databases, err := d.client.ListDatabases(ctx, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading databases", err),
+			"Could not list databases for project "+state.ProjectID.ValueString()+": "+errorDetail(err),
+		)
+		return
+	}
+
+	// The API does not support filtering, so filters are applied here.
+	state.Databases = []DatabasesDatabaseModel{}
+	for _, database := range databases {
+		region := ""
+		if database.Region != nil {
+			region = database.Region.ID
+		}
+
+		if !state.Name.IsNull() && database.Name != state.Name.ValueString() {
+			continue
+		}
+		if !state.Region.IsNull() && region != state.Region.ValueString() {
+			continue
+		}
+
+		model := DatabasesDatabaseModel{
+			ID:        types.StringValue(database.ID),
+			Name:      types.StringValue(database.Name),
+			Region:    types.StringNull(),
+			Status:    types.StringValue(database.Status),
+			IsDefault: types.BoolValue(database.IsDefault),
+			CreatedAt: types.StringValue(database.CreatedAt),
+		}
+
+		if region != "" {
+			model.Region = types.StringValue(region)
+		}
+
+		state.Databases = append(state.Databases, model)
+	}
+
+	tflog.Trace(ctx, "Read Prisma databases", map[string]any{
+		"count": len(state.Databases),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
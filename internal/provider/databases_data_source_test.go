@@ -0,0 +1,82 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestDatabasesDataSource tests listing databases, optionally by name and region.
+func TestDatabasesDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDatabasesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_databases.all", "databases.#", "2"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_databases.europe", "databases.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.prisma-postgres_databases.europe", "databases.0.id",
+						"prisma-postgres_database.europe", "id",
+					),
+					resource.TestCheckResourceAttr("data.prisma-postgres_databases.europe", "databases.0.name", "europe"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_databases.europe", "databases.0.region", "eu-west-3"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_databases.europe", "databases.0.status", "ready"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_databases.europe", "databases.0.is_default", "false"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_databases.by_name", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_databases.by_name", "databases.0.name", "america"),
+				),
+			},
+		},
+	})
+}
+
+func testDatabasesDataSourceConfig() string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+resource "prisma-postgres_database" "america" {
+  project_id = prisma-postgres_project.test.id
+  name       = "america"
+  region     = "us-east-1"
+}
+
+resource "prisma-postgres_database" "europe" {
+  project_id = prisma-postgres_project.test.id
+  name       = "europe"
+  region     = "eu-west-3"
+}
+
+data "prisma-postgres_databases" "all" {
+  project_id = prisma-postgres_project.test.id
+
+  depends_on = [prisma-postgres_database.america, prisma-postgres_database.europe]
+}
+
+data "prisma-postgres_databases" "europe" {
+  project_id = prisma-postgres_project.test.id
+  region     = "eu-west-3"
+
+  depends_on = [prisma-postgres_database.america, prisma-postgres_database.europe]
+}
+
+data "prisma-postgres_databases" "by_name" {
+  project_id = prisma-postgres_project.test.id
+  name       = "america"
+
+  depends_on = [prisma-postgres_database.america, prisma-postgres_database.europe]
+}
+`
+}
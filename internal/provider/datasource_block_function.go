@@ -0,0 +1,94 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DatasourceBlockFunction{}
+
+// DatasourceBlockFunction defines the function implementation.
+type DatasourceBlockFunction struct{}
+
+// NewDatasourceBlockFunction creates a new datasource_block function.
+func NewDatasourceBlockFunction() function.Function {
+	return &DatasourceBlockFunction{}
+}
+
+// Metadata returns the function name.
+func (f *DatasourceBlockFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "datasource_block"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *DatasourceBlockFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders a Prisma schema datasource block.",
+		MarkdownDescription: `
+Renders the ` + "`datasource db { ... }`" + ` block of a Prisma schema for the given
+Accelerate connection string and direct URL. The ` + "`directUrl`" + ` property is
+omitted when ` + "`direct_url`" + ` is empty.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "local_sensitive_file" "datasource" {
+  filename = "${path.module}/prisma/datasource.prisma"
+  content = provider::prisma-postgres::datasource_block(
+    prisma-postgres_database.example.connection_string,
+    prisma-postgres_database.example.direct_url,
+  )
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "The Accelerate connection string.",
+			},
+			function.StringParameter{
+				Name:        "direct_url",
+				Description: "The direct PostgreSQL URL, or an empty string.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the datasource block.
+func (f *DatasourceBlockFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var url, directURL string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &url, &directURL))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, renderDatasourceBlock(url, directURL)))
+}
+
+// renderDatasourceBlock returns a Prisma schema datasource block for the given
+// URLs.
+func renderDatasourceBlock(url, directURL string) string {
+	var b strings.Builder
+
+	b.WriteString("datasource db {\n")
+	if directURL == "" {
+		b.WriteString(`  provider = "postgresql"` + "\n")
+		b.WriteString("  url      = " + strconv.Quote(url) + "\n")
+	} else {
+		b.WriteString(`  provider  = "postgresql"` + "\n")
+		b.WriteString("  url       = " + strconv.Quote(url) + "\n")
+		b.WriteString("  directUrl = " + strconv.Quote(directURL) + "\n")
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
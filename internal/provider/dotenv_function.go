@@ -0,0 +1,123 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DotenvFunction{}
+
+// DotenvFunction defines the function implementation.
+type DotenvFunction struct{}
+
+// credentialsModel is the subset of database and connection attributes
+// accepted by functions that render connection settings.
+type credentialsModel struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+	DirectURL        types.String `tfsdk:"direct_url"`
+}
+
+// credentialsAttributeTypes describes the object accepted by functions that
+// render connection settings.
+var credentialsAttributeTypes = map[string]attr.Type{
+	"connection_string": types.StringType,
+	"direct_url":        types.StringType,
+}
+
+// NewDotenvFunction creates a new dotenv function.
+func NewDotenvFunction() function.Function {
+	return &DotenvFunction{}
+}
+
+// Metadata returns the function name.
+func (f *DotenvFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dotenv"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *DotenvFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders .env file content for a database or connection.",
+		MarkdownDescription: `
+Renders ` + "`.env`" + ` file content with ` + "`DATABASE_URL`" + ` set to the Accelerate connection string
+and ` + "`DIRECT_URL`" + ` set to the direct PostgreSQL URL. ` + "`DIRECT_URL`" + ` is omitted when the
+direct URL is empty. Values are single-quoted, so dotenv loaders and shells
+do not expand ` + "`$`" + ` or backslashes in passwords.
+
+Accepts a ` + "`prisma-postgres_database`" + ` or ` + "`prisma-postgres_connection`" + ` resource, or any
+object with ` + "`connection_string`" + ` and ` + "`direct_url`" + ` attributes.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "local_sensitive_file" "env" {
+  filename = "${path.module}/.env"
+  content  = provider::prisma-postgres::dotenv(prisma-postgres_database.example)
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "credentials",
+				AttributeTypes: credentialsAttributeTypes,
+				Description:    "A database or connection with connection_string and direct_url attributes.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the .env content.
+func (f *DotenvFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var credentials credentialsModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &credentials))
+	if resp.Error != nil {
+		return
+	}
+
+	// A null connection string usually means the credentials were not
+	// returned, such as for an imported resource.
+	if credentials.ConnectionString.ValueString() == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The connection_string attribute must not be null or empty")
+		return
+	}
+
+	result := renderDotenv(credentials.ConnectionString.ValueString(), credentials.DirectURL.ValueString())
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// renderDotenv returns .env file content for the given URLs.
+func renderDotenv(databaseURL, directURL string) string {
+	var b strings.Builder
+
+	b.WriteString("DATABASE_URL=" + dotenvQuote(databaseURL) + "\n")
+	if directURL != "" {
+		b.WriteString("DIRECT_URL=" + dotenvQuote(directURL) + "\n")
+	}
+
+	return b.String()
+}
+
+// dotenvQuote quotes a .env value. Single-quoted values are taken literally
+// by dotenv loaders and shells, while double-quoted values are expanded. A
+// value containing a single quote is double-quoted with the characters that
+// would be expanded escaped.
+func dotenvQuote(value string) string {
+	if !strings.Contains(value, "'") {
+		return "'" + value + "'"
+	}
+
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "`", "\\`").Replace(value)
+
+	return `"` + escaped + `"`
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// errorSummary returns a dedicated diagnostic summary for authentication and
+// authorization failures, so they are not mistaken for problems with the
+// resource itself, and summary otherwise.
+func errorSummary(summary string, err error) string {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return summary
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		return "Invalid Prisma service token"
+	case http.StatusForbidden:
+		return "Insufficient Prisma service token permissions"
+	}
+
+	return summary
+}
+
+// errorDetail formats an error for a diagnostic detail. Prisma API errors
+// include the request ID, which Prisma support needs to trace the request,
+// and a remediation hint for common failures.
+func errorDetail(err error) string {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return err.Error()
+	}
+
+	detail := err.Error()
+
+	if apiErr.RequestID != "" {
+		detail += "\n\nRequest ID: " + apiErr.RequestID
+	}
+
+	if hint := errorHint(apiErr.StatusCode); hint != "" {
+		detail += "\n\n" + hint
+	}
+
+	return detail
+}
+
+// errorHint returns a remediation hint for an API error status code.
+func errorHint(statusCode int) string {
+	switch {
+	case statusCode == http.StatusBadRequest:
+		return "The API rejected the request. Check the configured values, such as names and regions."
+	case statusCode == http.StatusUnauthorized:
+		return "The service token is invalid, expired, or was revoked. Create a new service token in the Prisma Console " +
+			"and update the service_token provider attribute or the PRISMA_SERVICE_TOKEN environment variable."
+	case statusCode == http.StatusForbidden:
+		return "The service token is valid but lacks permission for this workspace or operation. " +
+			"Check that the resource belongs to the token's workspace, or create a service token with the required role in the Prisma Console."
+	case statusCode == http.StatusNotFound:
+		return "The resource does not exist or is not visible to the service token's workspace."
+	case statusCode == http.StatusConflict:
+		return "The request conflicts with the current state of the resource. Refresh the state and try again."
+	case statusCode == http.StatusTooManyRequests:
+		return "The API rate limit was reached. Wait a moment and try again, or reduce parallelism with -parallelism."
+	case statusCode >= http.StatusInternalServerError:
+		return "The Prisma API is temporarily unavailable. Try again later."
+	}
+
+	return ""
+}
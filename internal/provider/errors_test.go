@@ -0,0 +1,96 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+func TestErrorDetail(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "non-API error",
+			err:      errors.New("request failed: connection refused"),
+			expected: "request failed: connection refused",
+		},
+		{
+			name: "API error with code and request ID",
+			err: &client.APIError{
+				StatusCode: 403,
+				Code:       "FORBIDDEN",
+				Message:    "Token lacks write scope",
+				RequestID:  "req_123",
+			},
+			expected: "Prisma API error (status 403, code FORBIDDEN): Token lacks write scope\n\n" +
+				"Request ID: req_123\n\n" +
+				"The service token is valid but lacks permission for this workspace or operation. " +
+				"Check that the resource belongs to the token's workspace, or create a service token with the required role in the Prisma Console.",
+		},
+		{
+			name:     "wrapped API error",
+			err:      fmt.Errorf("waiting: %w", &client.APIError{StatusCode: 503, Message: "Service Unavailable"}),
+			expected: "waiting: Prisma API error (status 503): Service Unavailable\n\nThe Prisma API is temporarily unavailable. Try again later.",
+		},
+		{
+			name:     "API error without hint",
+			err:      &client.APIError{StatusCode: 418, Message: "I'm a teapot"},
+			expected: "Prisma API error (status 418): I'm a teapot",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := errorDetail(tt.err)
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestErrorSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "non-API error",
+			err:      errors.New("request failed"),
+			expected: "Error reading project",
+		},
+		{
+			name:     "unauthorized",
+			err:      &client.APIError{StatusCode: 401, Message: "Unauthorized"},
+			expected: "Invalid Prisma service token",
+		},
+		{
+			name:     "forbidden",
+			err:      &client.APIError{StatusCode: 403, Message: "Forbidden"},
+			expected: "Insufficient Prisma service token permissions",
+		},
+		{
+			name:     "not found",
+			err:      &client.APIError{StatusCode: 404, Message: "Not Found"},
+			expected: "Error reading project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := errorSummary("Error reading project", tt.err)
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &KubernetesSecretDataFunction{}
+
+// KubernetesSecretDataFunction defines the function implementation.
+type KubernetesSecretDataFunction struct{}
+
+// NewKubernetesSecretDataFunction creates a new kubernetes_secret_data function.
+func NewKubernetesSecretDataFunction() function.Function {
+	return &KubernetesSecretDataFunction{}
+}
+
+// Metadata returns the function name.
+func (f *KubernetesSecretDataFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kubernetes_secret_data"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *KubernetesSecretDataFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders the base64-encoded data of a Kubernetes Secret for a database or connection.",
+		MarkdownDescription: `
+Renders the ` + "`data`" + ` map of a Kubernetes Secret with base64-encoded values.
+` + "`DATABASE_URL`" + ` is set to the Accelerate connection string and ` + "`DIRECT_URL`" + ` to the
+direct PostgreSQL URL. ` + "`DIRECT_URL`" + ` is omitted when the direct URL is empty.
+
+Accepts a ` + "`prisma-postgres_database`" + ` or ` + "`prisma-postgres_connection`" + ` resource, or any
+object with ` + "`connection_string`" + ` and ` + "`direct_url`" + ` attributes.
+
+The ` + "`data`" + ` argument of the ` + "`kubernetes_secret`" + ` resource encodes values itself, so
+use the map with ` + "`binary_data`" + ` there, or in manifests such as ` + "`kubernetes_manifest`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "kubernetes_manifest" "database" {
+  manifest = {
+    apiVersion = "v1"
+    kind       = "Secret"
+    metadata = {
+      name      = "database"
+      namespace = "default"
+    }
+    data = provider::prisma-postgres::kubernetes_secret_data(prisma-postgres_connection.example)
+  }
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "credentials",
+				AttributeTypes: credentialsAttributeTypes,
+				Description:    "A database or connection with connection_string and direct_url attributes.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run renders the Secret data.
+func (f *KubernetesSecretDataFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var credentials credentialsModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &credentials))
+	if resp.Error != nil {
+		return
+	}
+
+	result := kubernetesSecretData(credentials.ConnectionString.ValueString(), credentials.DirectURL.ValueString())
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// kubernetesSecretData returns Kubernetes Secret data with base64-encoded
+// values for the given URLs.
+func kubernetesSecretData(databaseURL, directURL string) map[string]string {
+	data := map[string]string{
+		"DATABASE_URL": base64.StdEncoding.EncodeToString([]byte(databaseURL)),
+	}
+
+	if directURL != "" {
+		data["DIRECT_URL"] = base64.StdEncoding.EncodeToString([]byte(directURL))
+	}
+
+	return data
+}
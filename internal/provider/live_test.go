@@ -0,0 +1,189 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// testAccLiveTokenEnv names the environment variable holding the service
+// token for acceptance tests against the live API. It is separate from
+// PRISMA_SERVICE_TOKEN so that a token in a developer's environment never
+// turns the mock-based tests into billable live runs.
+const testAccLiveTokenEnv = "PRISMA_ACC_SERVICE_TOKEN"
+
+// testAccLivePreCheck skips the test unless a live API token is configured
+// and points the provider at the live API.
+func testAccLivePreCheck(t *testing.T) {
+	t.Helper()
+
+	token := os.Getenv(testAccLiveTokenEnv)
+	if token == "" {
+		t.Skipf("%s must be set for acceptance tests against the live Prisma API", testAccLiveTokenEnv)
+	}
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", token)
+	t.Setenv("PRISMA_API_BASE_URL", os.Getenv("PRISMA_ACC_API_BASE_URL"))
+}
+
+// testAccLiveRegion returns the region live acceptance tests deploy to.
+func testAccLiveRegion() string {
+	if region := os.Getenv("PRISMA_ACC_REGION"); region != "" {
+		return region
+	}
+
+	return "us-east-1"
+}
+
+// testAccCheckLiveProjectDestroy verifies that all projects in state were
+// deleted, which also deletes their databases and connections.
+func testAccCheckLiveProjectDestroy(s *terraform.State) error {
+	c := client.NewClient(client.Config{
+		ServiceToken: os.Getenv("PRISMA_SERVICE_TOKEN"),
+		BaseURL:      os.Getenv("PRISMA_API_BASE_URL"),
+	})
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prisma-postgres_project" {
+			continue
+		}
+
+		_, err := c.GetProject(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("project %s still exists", rs.Primary.ID)
+		}
+
+		if apiErr, ok := err.(*client.APIError); !ok || apiErr.StatusCode != 404 {
+			return fmt.Errorf("checking project %s: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// TestAccLiveResources exercises create, read, import, rotation, and delete
+// of all resources against the live Prisma API.
+func TestAccLiveResources(t *testing.T) {
+	name := acctest.RandomWithPrefix(testAccResourcePrefix)
+	region := testAccLiveRegion()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccLivePreCheck(t) },
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		CheckDestroy:             testAccCheckLiveProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLiveResourcesConfig(name, region, "1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_project.test", tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("region"), knownvalue.StringExact(region)),
+					statecheck.ExpectKnownValue("prisma-postgres_database.test", tfjsonpath.New("connection_string"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("prisma-postgres_connection.test", tfjsonpath.New("api_key"), knownvalue.NotNull()),
+				},
+			},
+			{
+				ResourceName:      "prisma-postgres_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "prisma-postgres_database.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"connection_string",
+					"direct_url",
+					"direct_host",
+					"direct_user",
+					"direct_password",
+					"libpq_dsn",
+					"status",
+					"storage_used_gib",
+				},
+			},
+			{
+				ResourceName:      "prisma-postgres_connection.test",
+				ImportState:       true,
+				ImportStateIdFunc: testConnectionImportStateIDFunc("prisma-postgres_connection.test"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"api_key",
+					"connection_string",
+					"database_name",
+					"direct_url",
+					"host",
+					"libpq_dsn",
+					"password",
+					"port",
+					"rotation_triggers",
+					"user",
+				},
+			},
+			// Rotation creates a new key before deleting the previous one.
+			{
+				Config: testAccLiveResourcesConfig(name, region, "2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_connection.test", tfjsonpath.New("api_key"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+// TestAccLiveDataSources reads the data sources backed by list endpoints,
+// which page through results on the live API.
+func TestAccLiveDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccLivePreCheck(t) },
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "prisma-postgres_regions" "test" {}
+
+data "prisma-postgres_workspace" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.prisma-postgres_regions.test", tfjsonpath.New("regions"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.prisma-postgres_workspace.test", tfjsonpath.New("id"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccLiveResourcesConfig(name, region, rotation string) string {
+	return fmt.Sprintf(`
+resource "prisma-postgres_project" "test" {
+  name = %[1]q
+}
+
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = %[1]q
+  region     = %[2]q
+}
+
+resource "prisma-postgres_connection" "test" {
+  database_id = prisma-postgres_database.test.id
+  name        = %[1]q
+
+  rotation_triggers = {
+    rotation = %[3]q
+  }
+}
+`, name, region, rotation)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// nullResourceTypeName is the type name of the hashicorp/null placeholder
+// resource that can be moved into this provider.
+const nullResourceTypeName = "null_resource"
+
+// moveStateFromPrisma returns a state mover that accepts resources whose
+// type name ends in typeSuffix from other Prisma providers, such as
+// community forks, as well as null_resource placeholders whose triggers
+// hold the resource attributes. Attributes the target schema does not
+// define are dropped, and the next refresh fills in the rest from the API.
+// The identityAttributes are copied into the target identity.
+func moveStateFromPrisma(typeSuffix string, identityAttributes ...string) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			// State movers run without a configured client.
+			ctx = client.MaskSensitiveLogValues(ctx)
+
+			if req.SourceRawState == nil {
+				return
+			}
+
+			var source map[string]any
+			if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Move Resource State",
+					"Could not decode the source resource state: "+err.Error(),
+				)
+				return
+			}
+
+			var attributes map[string]any
+			switch {
+			case req.SourceTypeName == nullResourceTypeName:
+				triggers, _ := source["triggers"].(map[string]any)
+				attributes = triggers
+			case strings.Contains(strings.ToLower(req.SourceProviderAddress), "prisma") &&
+				strings.HasSuffix(req.SourceTypeName, typeSuffix):
+				attributes = source
+			default:
+				// Not a supported source; let the framework report it.
+				return
+			}
+
+			if id, _ := attributes["id"].(string); id == "" {
+				resp.Diagnostics.AddError(
+					"Unable to Move Resource State",
+					"The source "+req.SourceTypeName+" resource does not have an \"id\" attribute. "+
+						"When moving from "+nullResourceTypeName+", set the resource attributes, including \"id\", in its triggers.",
+				)
+				return
+			}
+
+			tflog.Debug(ctx, "Moving resource state", map[string]any{
+				"source_provider_address": req.SourceProviderAddress,
+				"source_type_name":        req.SourceTypeName,
+				"id":                      attributes["id"],
+			})
+
+			raw, err := json.Marshal(attributes)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Move Resource State",
+					"Could not encode the source resource state: "+err.Error(),
+				)
+				return
+			}
+
+			targetType := resp.TargetState.Schema.Type().TerraformType(ctx)
+			target, err := tftypes.ValueFromJSONWithOpts(raw, targetType, tftypes.ValueFromJSONOpts{
+				IgnoreUndefinedAttributes: true,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Move Resource State",
+					"The source "+req.SourceTypeName+" resource state is not compatible with this resource: "+err.Error(),
+				)
+				return
+			}
+
+			resp.TargetState.Raw = target
+
+			if resp.TargetIdentity == nil {
+				return
+			}
+
+			for _, name := range identityAttributes {
+				value, _ := attributes[name].(string)
+				resp.Diagnostics.Append(resp.TargetIdentity.SetAttribute(ctx, path.Root(name), value)...)
+			}
+		},
+	}
+}
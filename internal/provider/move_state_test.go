@@ -0,0 +1,114 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMoveStateFromPrisma(t *testing.T) {
+	ctx := context.Background()
+	r := &ConnectionResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	tests := []struct {
+		name            string
+		providerAddress string
+		typeName        string
+		rawState        string
+		expectedMoved   bool
+		expectedError   bool
+	}{
+		{
+			name:            "community provider",
+			providerAddress: "registry.terraform.io/example/prisma",
+			typeName:        "prisma_connection",
+			rawState:        `{"id":"con_1","database_id":"db_1","name":"api","unknown":"ignored"}`,
+			expectedMoved:   true,
+		},
+		{
+			name:            "null_resource triggers",
+			providerAddress: "registry.terraform.io/hashicorp/null",
+			typeName:        "null_resource",
+			rawState:        `{"id":"123","triggers":{"id":"con_1","database_id":"db_1","name":"api"}}`,
+			expectedMoved:   true,
+		},
+		{
+			name:            "null_resource without id",
+			providerAddress: "registry.terraform.io/hashicorp/null",
+			typeName:        "null_resource",
+			rawState:        `{"id":"123","triggers":{"name":"api"}}`,
+			expectedError:   true,
+		},
+		{
+			name:            "unrelated provider",
+			providerAddress: "registry.terraform.io/hashicorp/random",
+			typeName:        "random_connection",
+			rawState:        `{"id":"con_1"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.MoveStateRequest{
+				SourceProviderAddress: tt.providerAddress,
+				SourceTypeName:        tt.typeName,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(tt.rawState)},
+			}
+			resp := resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+				TargetIdentity: &tfsdk.ResourceIdentity{
+					Schema: identityResp.IdentitySchema,
+					Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			moveStateFromPrisma("_connection", "database_id", "id").StateMover(ctx, req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.expectedError {
+				t.Fatalf("expected error %t, got %t: %v", tt.expectedError, got, resp.Diagnostics)
+			}
+
+			if got := !resp.TargetState.Raw.IsNull(); got != tt.expectedMoved {
+				t.Fatalf("expected moved %t, got %t", tt.expectedMoved, got)
+			}
+
+			if !tt.expectedMoved {
+				return
+			}
+
+			var state ConnectionResourceModel
+			resp.Diagnostics.Append(resp.TargetState.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if state.ID.ValueString() != "con_1" || state.DatabaseID.ValueString() != "db_1" || state.Name.ValueString() != "api" {
+				t.Errorf("unexpected state: %+v", state)
+			}
+
+			var id types.String
+			resp.Diagnostics.Append(resp.TargetIdentity.GetAttribute(ctx, path.Root("id"), &id)...)
+			if id.ValueString() != "con_1" {
+				t.Errorf("expected %q, got %q", "con_1", id.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/rand"
+	"slices"
+	"strings"
+)
+
+// namePrefixSuffixLength is the length of the random suffix appended to a
+// name_prefix.
+const namePrefixSuffixLength = 8
+
+// uniqueName returns prefix followed by a random lowercase suffix that is
+// not one of the existing names. The API does not require names to be
+// unique, so the names of the objects the new one sits next to are checked
+// to keep parallel environments apart.
+func uniqueName(prefix string, existing []string) string {
+	for {
+		name := prefix + strings.ToLower(rand.Text()[:namePrefixSuffixLength])
+		if !slices.Contains(existing, name) {
+			return name
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+// TestUniqueName verifies that generated names keep the prefix and avoid
+// existing names.
+func TestUniqueName(t *testing.T) {
+	pattern := regexp.MustCompile(`^app-[a-z2-7]{8}$`)
+
+	var existing []string
+	for range 100 {
+		name := uniqueName("app-", existing)
+		if !pattern.MatchString(name) {
+			t.Fatalf("uniqueName() = %q, want a match for %s", name, pattern)
+		}
+
+		if slices.Contains(existing, name) {
+			t.Fatalf("uniqueName() = %q, which already exists", name)
+		}
+
+		existing = append(existing, name)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NearestRegionFunction{}
+
+// location is the approximate latitude and longitude of a region.
+type location struct {
+	lat, lon float64
+}
+
+// cloudRegionLocations maps AWS and Google Cloud region IDs to their
+// locations. Prisma Postgres region IDs are AWS region IDs, so they map to
+// themselves.
+var cloudRegionLocations = map[string]location{
+	// AWS
+	"af-south-1":     {-33.9, 18.4},
+	"ap-east-1":      {22.3, 114.2},
+	"ap-northeast-1": {35.7, 139.7},
+	"ap-northeast-2": {37.6, 127.0},
+	"ap-northeast-3": {34.7, 135.5},
+	"ap-south-1":     {19.1, 72.9},
+	"ap-south-2":     {17.4, 78.5},
+	"ap-southeast-1": {1.4, 103.8},
+	"ap-southeast-2": {-33.9, 151.2},
+	"ap-southeast-3": {-6.2, 106.8},
+	"ap-southeast-4": {-37.8, 145.0},
+	"ap-southeast-5": {3.1, 101.7},
+	"ap-southeast-7": {13.8, 100.5},
+	"ca-central-1":   {45.5, -73.6},
+	"ca-west-1":      {51.0, -114.1},
+	"eu-central-1":   {50.1, 8.7},
+	"eu-central-2":   {47.4, 8.5},
+	"eu-north-1":     {59.3, 18.1},
+	"eu-south-1":     {45.5, 9.2},
+	"eu-south-2":     {41.6, -0.9},
+	"eu-west-1":      {53.3, -6.3},
+	"eu-west-2":      {51.5, -0.1},
+	"eu-west-3":      {48.9, 2.4},
+	"il-central-1":   {32.1, 34.8},
+	"me-central-1":   {25.2, 55.3},
+	"me-south-1":     {26.1, 50.6},
+	"mx-central-1":   {20.6, -100.4},
+	"sa-east-1":      {-23.5, -46.6},
+	"us-east-1":      {38.9, -77.4},
+	"us-east-2":      {40.0, -83.0},
+	"us-west-1":      {37.4, -121.9},
+	"us-west-2":      {45.8, -119.7},
+
+	// Google Cloud
+	"africa-south1":           {-26.2, 28.0},
+	"asia-east1":              {24.1, 120.7},
+	"asia-east2":              {22.3, 114.2},
+	"asia-northeast1":         {35.7, 139.7},
+	"asia-northeast2":         {34.7, 135.5},
+	"asia-northeast3":         {37.6, 127.0},
+	"asia-south1":             {19.1, 72.9},
+	"asia-south2":             {28.6, 77.2},
+	"asia-southeast1":         {1.4, 103.8},
+	"asia-southeast2":         {-6.2, 106.8},
+	"australia-southeast1":    {-33.9, 151.2},
+	"australia-southeast2":    {-37.8, 145.0},
+	"europe-central2":         {52.2, 21.0},
+	"europe-north1":           {60.6, 27.2},
+	"europe-north2":           {59.3, 18.1},
+	"europe-southwest1":       {40.4, -3.7},
+	"europe-west1":            {50.4, 3.8},
+	"europe-west2":            {51.5, -0.1},
+	"europe-west3":            {50.1, 8.7},
+	"europe-west4":            {53.4, 6.8},
+	"europe-west6":            {47.4, 8.5},
+	"europe-west8":            {45.5, 9.2},
+	"europe-west9":            {48.9, 2.4},
+	"europe-west10":           {52.5, 13.4},
+	"europe-west12":           {45.1, 7.7},
+	"me-central1":             {25.3, 51.5},
+	"me-central2":             {26.4, 50.1},
+	"me-west1":                {32.1, 34.8},
+	"northamerica-northeast1": {45.5, -73.6},
+	"northamerica-northeast2": {43.7, -79.4},
+	"northamerica-south1":     {20.6, -100.4},
+	"southamerica-east1":      {-23.5, -46.6},
+	"southamerica-west1":      {-33.4, -70.7},
+	"us-central1":             {41.3, -95.9},
+	"us-east1":                {33.2, -80.0},
+	"us-east4":                {39.0, -77.5},
+	"us-east5":                {40.0, -83.0},
+	"us-south1":               {32.8, -96.8},
+	"us-west1":                {45.6, -121.2},
+	"us-west2":                {34.1, -118.2},
+	"us-west3":                {40.8, -111.9},
+	"us-west4":                {36.2, -115.1},
+}
+
+// NearestRegionFunction defines the function implementation.
+type NearestRegionFunction struct{}
+
+// NewNearestRegionFunction creates a new nearest_region function.
+func NewNearestRegionFunction() function.Function {
+	return &NearestRegionFunction{}
+}
+
+// Metadata returns the function name.
+func (f *NearestRegionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "nearest_region"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *NearestRegionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the Prisma Postgres region nearest to an AWS or Google Cloud region.",
+		MarkdownDescription: `
+Returns the Prisma Postgres region nearest to an AWS or Google Cloud region,
+such as ` + "`eu-north-1`" + ` or ` + "`us-central1`" + `, to co-locate databases with compute.
+
+Functions cannot call the API, so pass the IDs of the available regions from
+the ` + "`prisma-postgres_regions`" + ` data source to only choose among them. When
+` + "`available_regions`" + ` is null, all regions known to the provider are
+considered. Regions the provider does not know are skipped. Distances are
+measured between the approximate locations of the regions.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_regions" "available" {
+  status = "available"
+}
+
+resource "prisma-postgres_database" "example" {
+  project_id = prisma-postgres_project.example.id
+  name       = "production"
+  region = provider::prisma-postgres::nearest_region(
+    var.aws_region,
+    data.prisma-postgres_regions.available.regions[*].id,
+  )
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cloud_region",
+				Description: "An AWS or Google Cloud region ID.",
+			},
+			function.ListParameter{
+				Name:           "available_regions",
+				ElementType:    types.StringType,
+				AllowNullValue: true,
+				Description:    "The Prisma Postgres region IDs or aliases to choose from, or null for all regions.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run returns the nearest region.
+func (f *NearestRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cloudRegion string
+	var available types.List
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cloudRegion, &available))
+	if resp.Error != nil {
+		return
+	}
+
+	from, ok := cloudRegionLocations[strings.ToLower(strings.TrimSpace(cloudRegion))]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(
+			"Unknown cloud region %q. Use an AWS region such as eu-central-1 or a Google Cloud region such as europe-west3.", cloudRegion,
+		))
+		return
+	}
+
+	candidates := regionIDs
+	if !available.IsNull() {
+		var regions []string
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, available.ElementsAs(ctx, &regions, false)))
+		if resp.Error != nil {
+			return
+		}
+
+		// Regions added to the API after this provider version have no
+		// known location and are skipped.
+		candidates = make([]string, 0, len(regions))
+		for _, region := range regions {
+			if id, ok := normalizeRegion(region); ok {
+				candidates = append(candidates, id)
+			}
+		}
+	}
+
+	nearest, ok := nearestRegion(from, candidates)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf(
+			"None of the available regions is known to the provider. Known regions are: %s.", strings.Join(regionIDs, ", "),
+		))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, nearest))
+}
+
+// nearestRegion returns the region closest to from, preferring the earlier
+// region on ties.
+func nearestRegion(from location, regions []string) (string, bool) {
+	var nearest string
+	shortest := math.Inf(1)
+
+	for _, region := range regions {
+		if d := distance(from, cloudRegionLocations[region]); d < shortest {
+			nearest, shortest = region, d
+		}
+	}
+
+	return nearest, nearest != ""
+}
+
+// distance returns the great-circle distance between two locations in
+// kilometers.
+func distance(a, b location) float64 {
+	const earthRadius = 6371
+
+	lat1, lat2 := a.lat*math.Pi/180, b.lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.lon - a.lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
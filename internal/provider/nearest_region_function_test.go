@@ -0,0 +1,87 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestNearestRegion verifies that cloud regions map to the closest of the
+// given regions.
+func TestNearestRegion(t *testing.T) {
+	tests := []struct {
+		name        string
+		cloudRegion string
+		regions     []string
+		expected    string
+		ok          bool
+	}{
+		{name: "same region", cloudRegion: "eu-west-3", regions: regionIDs, expected: "eu-west-3", ok: true},
+		{name: "aws region", cloudRegion: "us-east-2", regions: regionIDs, expected: "us-east-1", ok: true},
+		{name: "aws region in another continent", cloudRegion: "ap-southeast-2", regions: regionIDs, expected: "ap-southeast-1", ok: true},
+		{name: "gcp region", cloudRegion: "europe-north1", regions: regionIDs, expected: "eu-central-1", ok: true},
+		{name: "gcp region on the west coast", cloudRegion: "us-west2", regions: regionIDs, expected: "us-west-1", ok: true},
+		{name: "subset of regions", cloudRegion: "us-west2", regions: []string{"us-east-1", "eu-west-3"}, expected: "us-east-1", ok: true},
+		{name: "no regions", cloudRegion: "us-west2", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := nearestRegion(cloudRegionLocations[tt.cloudRegion], tt.regions)
+			if ok != tt.ok {
+				t.Fatalf("expected ok %t, got %t", tt.ok, ok)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestNearestRegionFunction tests calling the nearest_region function from configuration.
+func TestNearestRegionFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "all" {
+  value = provider::prisma-postgres::nearest_region("EU-NORTH-1", null)
+}
+
+output "available" {
+  value = provider::prisma-postgres::nearest_region("europe-north1", ["paris", "us-east-1", "mars-north-1"])
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("all", "eu-central-1"),
+					resource.TestCheckOutput("available", "eu-west-3"),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::prisma-postgres::nearest_region("mars-north-1", null)
+}
+`,
+				ExpectError: regexp.MustCompile(`Unknown cloud region\s+"mars-north-1"`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::prisma-postgres::nearest_region("us-east-2", ["mars-north-1"])
+}
+`,
+				ExpectError: regexp.MustCompile(`None of the available\s+regions is known`),
+			},
+		},
+	})
+}
@@ -0,0 +1,142 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeRegionFunction{}
+
+// regionIDs lists the regions accepted by the API when creating projects and
+// databases.
+var regionIDs = []string{
+	"us-east-1",
+	"us-west-1",
+	"eu-west-3",
+	"eu-central-1",
+	"ap-northeast-1",
+	"ap-southeast-1",
+}
+
+// regionAliases maps normalized human-friendly names to region IDs.
+var regionAliases = map[string]string{
+	"us-east":          "us-east-1",
+	"virginia":         "us-east-1",
+	"n-virginia":       "us-east-1",
+	"north-virginia":   "us-east-1",
+	"us-west":          "us-west-1",
+	"california":       "us-west-1",
+	"n-california":     "us-west-1",
+	"north-california": "us-west-1",
+	"paris":            "eu-west-3",
+	"france":           "eu-west-3",
+	"frankfurt":        "eu-central-1",
+	"germany":          "eu-central-1",
+	"tokyo":            "ap-northeast-1",
+	"japan":            "ap-northeast-1",
+	"singapore":        "ap-southeast-1",
+}
+
+// NormalizeRegionFunction defines the function implementation.
+type NormalizeRegionFunction struct{}
+
+// NewNormalizeRegionFunction creates a new normalize_region function.
+func NewNormalizeRegionFunction() function.Function {
+	return &NormalizeRegionFunction{}
+}
+
+// Metadata returns the function name.
+func (f *NormalizeRegionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_region"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *NormalizeRegionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalizes a region name to a Prisma Postgres region ID.",
+		MarkdownDescription: `
+Normalizes a region name to a Prisma Postgres region ID and fails on unknown
+regions.
+
+Case, surrounding whitespace, underscores and spaces are ignored, so
+` + "`US_EAST_1`" + ` becomes ` + "`us-east-1`" + `. City, country and short names such as
+` + "`frankfurt`" + `, ` + "`japan`" + ` or ` + "`us-west`" + ` are mapped to their region.
+
+## Example Usage
+
+` + "```hcl" + `
+variable "region" {
+  type    = string
+  default = "Frankfurt"
+}
+
+resource "prisma-postgres_database" "example" {
+  project_id = prisma-postgres_project.example.id
+  name       = "production"
+  region     = provider::prisma-postgres::normalize_region(var.region)
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "region",
+				Description: "A region ID or alias.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the region.
+func (f *NormalizeRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, ok := normalizeRegion(region)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(
+			"Unknown region %q. Valid regions are: %s.", region, strings.Join(regionIDs, ", "),
+		))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
+
+// normalizeRegion returns the region ID for a region ID or alias.
+func normalizeRegion(region string) (string, bool) {
+	key := strings.ToLower(strings.TrimSpace(region))
+	key = strings.NewReplacer("_", "-", " ", "-").Replace(key)
+
+	for _, id := range regionIDs {
+		if key == id {
+			return id, true
+		}
+	}
+
+	id, ok := regionAliases[key]
+	return id, ok
+}
+
+// regionsEquivalent reports whether two region IDs or aliases refer to the
+// same region. Unknown regions are compared case-insensitively.
+func regionsEquivalent(a, b string) bool {
+	normalizedA, okA := normalizeRegion(a)
+	normalizedB, okB := normalizeRegion(b)
+	if okA && okB {
+		return normalizedA == normalizedB
+	}
+
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
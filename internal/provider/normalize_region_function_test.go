@@ -0,0 +1,98 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestNormalizeRegion verifies region IDs and aliases are normalized.
+func TestNormalizeRegion(t *testing.T) {
+	tests := []struct {
+		name     string
+		region   string
+		expected string
+		ok       bool
+	}{
+		{name: "region id", region: "eu-west-3", expected: "eu-west-3", ok: true},
+		{name: "upper case with underscores", region: "US_EAST_1", expected: "us-east-1", ok: true},
+		{name: "surrounding whitespace", region: "  ap-northeast-1 ", expected: "ap-northeast-1", ok: true},
+		{name: "city alias", region: "Frankfurt", expected: "eu-central-1", ok: true},
+		{name: "alias with spaces", region: "N Virginia", expected: "us-east-1", ok: true},
+		{name: "unknown", region: "mars-north-1", ok: false},
+		{name: "empty", region: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := normalizeRegion(tt.region)
+			if ok != tt.ok {
+				t.Fatalf("expected ok %t, got %t", tt.ok, ok)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestRegionsEquivalent verifies that spellings of the same region compare
+// equal.
+func TestRegionsEquivalent(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected bool
+	}{
+		{name: "identical", a: "us-east-1", b: "us-east-1", expected: true},
+		{name: "case", a: "US-EAST-1", b: "us-east-1", expected: true},
+		{name: "alias", a: "Paris", b: "eu-west-3", expected: true},
+		{name: "different regions", a: "us-east-1", b: "us-west-1", expected: false},
+		{name: "unknown regions by case", a: "Mars-North-1", b: "mars-north-1", expected: true},
+		{name: "unknown and known region", a: "mars-north-1", b: "us-east-1", expected: false},
+		{name: "empty", a: "", b: "us-east-1", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := regionsEquivalent(tt.a, tt.b); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestNormalizeRegionFunction tests calling the normalize_region function from configuration.
+func TestNormalizeRegionFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::prisma-postgres::normalize_region("Tokyo")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ap-northeast-1"),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::prisma-postgres::normalize_region("mars-north-1")
+}
+`,
+				ExpectError: regexp.MustCompile(`Unknown region "mars-north-1"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PgpassLineFunction{}
+
+// PgpassLineFunction defines the function implementation.
+type PgpassLineFunction struct{}
+
+// NewPgpassLineFunction creates a new pgpass_line function.
+func NewPgpassLineFunction() function.Function {
+	return &PgpassLineFunction{}
+}
+
+// Metadata returns the function name.
+func (f *PgpassLineFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pgpass_line"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *PgpassLineFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders a .pgpass file line from connection components.",
+		MarkdownDescription: `
+Renders a ` + "`.pgpass`" + ` line (` + "`hostname:port:database:username:password`" + `) without a
+trailing newline. Colons and backslashes in the values are escaped with a
+backslash. A field that is exactly ` + "`*`" + ` matches any value.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "local_sensitive_file" "pgpass" {
+  filename        = "${path.module}/.pgpass"
+  file_permission = "0600"
+  content = "${provider::prisma-postgres::pgpass_line(
+    prisma-postgres_connection.example.host,
+    prisma-postgres_connection.example.port,
+    prisma-postgres_connection.example.database_name,
+    prisma-postgres_connection.example.user,
+    prisma-postgres_connection.example.password,
+  )}\n"
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "host",
+				Description: "The database host, or * for any host.",
+			},
+			function.StringParameter{
+				Name:        "port",
+				Description: "The database port, or * for any port.",
+			},
+			function.StringParameter{
+				Name:        "database",
+				Description: "The database name, or * for any database.",
+			},
+			function.StringParameter{
+				Name:        "user",
+				Description: "The database user, or * for any user.",
+			},
+			function.StringParameter{
+				Name:        "password",
+				Description: "The database password.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the .pgpass line.
+func (f *PgpassLineFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host, port, database, user, password string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &host, &port, &database, &user, &password))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, renderPgpassLine(host, port, database, user, password)))
+}
+
+// pgpassEscaper escapes the characters that are special in .pgpass fields.
+var pgpassEscaper = strings.NewReplacer(`\`, `\\`, `:`, `\:`)
+
+// renderPgpassLine returns a .pgpass line for the given fields.
+func renderPgpassLine(fields ...string) string {
+	escaped := make([]string, len(fields))
+	for i, field := range fields {
+		escaped[i] = pgpassEscaper.Replace(field)
+	}
+
+	return strings.Join(escaped, ":")
+}
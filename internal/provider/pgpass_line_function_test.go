@@ -0,0 +1,65 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestRenderPgpassLine verifies .pgpass line rendering and escaping.
+func TestRenderPgpassLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   []string
+		expected string
+	}{
+		{
+			name:     "simple fields",
+			fields:   []string{"db.prisma.io", "5432", "postgres", "prisma_user", "secret"},
+			expected: "db.prisma.io:5432:postgres:prisma_user:secret",
+		},
+		{
+			name:     "colons and backslashes",
+			fields:   []string{"db.prisma.io", "5432", "postgres", "prisma_user", `p:a\ss`},
+			expected: `db.prisma.io:5432:postgres:prisma_user:p\:a\\ss`,
+		},
+		{
+			name:     "wildcards",
+			fields:   []string{"*", "*", "*", "prisma_user", "secret"},
+			expected: "*:*:*:prisma_user:secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderPgpassLine(tt.fields...); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestPgpassLineFunction tests calling the pgpass_line function from
+// configuration.
+func TestPgpassLineFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::prisma-postgres::pgpass_line("db.prisma.io", 5432, "postgres", "prisma_user", "p:ss")
+}
+`,
+				Check: resource.TestCheckOutput("test", `db.prisma.io:5432:postgres:prisma_user:p\:ss`),
+			},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ProjectDataSource{}
+	_ datasource.DataSourceWithConfigure = &ProjectDataSource{}
+)
+
+// ProjectDataSource defines the data source implementation.
+type ProjectDataSource struct {
+	client *client.Client
+}
+
+// ProjectDataSourceModel describes the data source data model.
+type ProjectDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	WorkspaceName types.String `tfsdk:"workspace_name"`
+}
+
+// NewProjectDataSource creates a new project data source.
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *ProjectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema defines the schema for the data source.
+func (d *ProjectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Prisma Postgres project by ID.",
+		MarkdownDescription: `
+Looks up an existing Prisma Postgres project by ID.
+
+Use this data source to attach databases to a project that is managed outside
+of the current configuration.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_project" "shared" {
+  id = "proj_abc123"
+}
+
+resource "prisma-postgres_database" "example" {
+  project_id = data.prisma-postgres_project.shared.id
+  name       = "production"
+  region     = "us-east-1"
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the project.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the project.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the project was created.",
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "The ID of the workspace the project belongs to.",
+				Computed:    true,
+			},
+			"workspace_name": schema.StringAttribute{
+				Description: "The name of the workspace the project belongs to.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProjectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state ProjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma project", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	project, err := d.client.GetProject(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading project", err),
+			"Could not read project ID "+state.ID.ValueString()+": "+errorDetail(err),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(project.Name)
+	state.CreatedAt = types.StringValue(project.CreatedAt)
+	state.WorkspaceID = types.StringNull()
+	state.WorkspaceName = types.StringNull()
+
+	if project.Workspace != nil {
+		state.WorkspaceID = types.StringValue(project.Workspace.ID)
+		state.WorkspaceName = types.StringValue(project.Workspace.Name)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestProjectDataSource tests looking up a project by ID.
+func TestProjectDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.prisma-postgres_project.test", "id",
+						"prisma-postgres_project.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.prisma-postgres_project.test", "name", "test-project"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_project.test", "created_at", "2025-01-07T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_project.test", "workspace_id", "wksp_test"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_project.test", "workspace_name", "Test Workspace"),
+				),
+			},
+		},
+	})
+}
+
+func testProjectDataSourceConfig() string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name = "test-project"
+}
+
+data "prisma-postgres_project" "test" {
+  id = prisma-postgres_project.test.id
+}
+`
+}
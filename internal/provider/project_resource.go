@@ -6,12 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -22,19 +26,29 @@ import (
 var (
 	_ resource.Resource                = &ProjectResource{}
 	_ resource.ResourceWithConfigure   = &ProjectResource{}
+	_ resource.ResourceWithIdentity    = &ProjectResource{}
 	_ resource.ResourceWithImportState = &ProjectResource{}
+	_ resource.ResourceWithModifyPlan  = &ProjectResource{}
+	_ resource.ResourceWithMoveState   = &ProjectResource{}
 )
 
 // ProjectResource defines the resource implementation.
 type ProjectResource struct {
-	client *client.Client
+	client      *client.Client
+	namePattern *regexp.Regexp
 }
 
 // ProjectResourceModel describes the resource data model.
 type ProjectResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	CreatedAt types.String `tfsdk:"created_at"`
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+// ProjectResourceIdentityModel describes the resource identity.
+type ProjectResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 // NewProjectResource creates a new project resource.
@@ -74,8 +88,23 @@ resource "prisma-postgres_project" "example" {
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the project.",
-				Required:    true,
+				Description: "The name of the project. Exactly one of name and name_prefix must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: append(nameValidators(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name_prefix")),
+				),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Creates a unique name beginning with the prefix, followed by 8 random lowercase characters that no other project next to it uses. Conflicts with name.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -97,20 +126,23 @@ func (r *ProjectResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*resourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.resourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.namePattern = data.namePattern
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.client.MaskLogValues(ctx)
+
 	var plan ProjectResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -118,6 +150,33 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// With name_prefix, the name is generated here.
+	if plan.Name.IsUnknown() {
+		projects, err := r.client.ListProjects(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				errorSummary("Error creating project", err),
+				"Could not list projects to generate a unique name: "+errorDetail(err),
+			)
+			return
+		}
+
+		names := make([]string, 0, len(projects))
+		for _, project := range projects {
+			names = append(names, project.Name)
+		}
+
+		plan.Name = types.StringValue(uniqueName(plan.NamePrefix.ValueString(), names))
+
+		// The policy could not be checked at plan time.
+		if r.namePattern != nil {
+			validateNamePolicy(r.namePattern, path.Root("name"), plan.Name, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	tflog.Debug(ctx, "Creating Prisma project", map[string]any{
 		"name": plan.Name.ValueString(),
 	})
@@ -125,8 +184,8 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	project, err := r.client.CreateProject(ctx, plan.Name.ValueString(), false)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error creating project",
-			"Could not create project, unexpected error: "+err.Error(),
+			errorSummary("Error creating project", err),
+			"Could not create project, unexpected error: "+errorDetail(err),
 		)
 		return
 	}
@@ -140,10 +199,13 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ProjectResourceIdentityModel{ID: plan.ID})...)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = r.client.MaskLogValues(ctx)
+
 	var state ProjectResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -167,28 +229,50 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		}
 
 		resp.Diagnostics.AddError(
-			"Error reading project",
-			"Could not read project ID "+state.ID.ValueString()+": "+err.Error(),
+			errorSummary("Error reading project", err),
+			"Could not read project ID "+state.ID.ValueString()+": "+errorDetail(err),
 		)
 		return
 	}
 
-	state.Name = types.StringValue(project.Name)
+	state.Name = readName(state.Name, project.Name)
 	state.CreatedAt = types.StringValue(project.CreatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ProjectResourceIdentityModel{ID: state.ID})...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
+// The API cannot change projects, so attributes sent to it require
+// replacement, and Update only stores the plan, keeping the computed values.
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Prisma projects cannot be updated. Changes to attributes require replacing the resource.",
-	)
+	ctx = r.client.MaskLogValues(ctx)
+
+	var plan, state ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt = state.CreatedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// ModifyPlan enforces the provider's naming policy.
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx = r.client.MaskLogValues(ctx)
+
+	checkNamePolicy(ctx, r.namePattern, req, path.Root("name"), &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.client.MaskLogValues(ctx)
+
 	var state ProjectResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -210,13 +294,35 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		}
 
 		resp.Diagnostics.AddError(
-			"Error deleting project",
-			"Could not delete project ID "+state.ID.ValueString()+": "+err.Error(),
+			errorSummary("Error deleting project", err),
+			"Could not delete project ID "+state.ID.ValueString()+": "+errorDetail(err),
 		)
 	}
 }
 
-// ImportState imports the resource state.
+// MoveState returns the state movers for moving resources from other Prisma
+// providers or null_resource placeholders into this resource.
+func (r *ProjectResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveStateFromPrisma("_project", "id"),
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *ProjectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the project.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ImportState imports the resource state by ID or identity.
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ctx = r.client.MaskLogValues(ctx)
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -4,16 +4,22 @@
 package provider
 
 import (
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // TestProjectResource tests the project resource lifecycle.
 func TestProjectResource(t *testing.T) {
 	mock := newMockAPIServer()
 	defer mock.Close()
-	mock.SetupProjectHandlers()
 
 	// Set environment for the test.
 	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
@@ -31,6 +37,110 @@ func TestProjectResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("prisma-postgres_project.test", "created_at"),
 				),
 			},
+			// ImportState testing.
+			{
+				ResourceName:      "prisma-postgres_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestProjectResourceIdentity tests that the project exposes its identity
+// and can be imported by it.
+func TestProjectResourceIdentity(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectResourceConfig("test-project"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("prisma-postgres_project.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("prisma-postgres_project.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "prisma-postgres_project.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+// TestProjectResourceUnauthorized tests that an invalid service token
+// surfaces as a dedicated diagnostic.
+func TestProjectResourceUnauthorized(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+	mock.Handle("POST", "/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req_test")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": {"code": "UNAUTHORIZED", "message": "Invalid token"}}`))
+	})
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "expired-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testProjectResourceConfig("test-project"),
+				ExpectError: regexp.MustCompile(`(?s)Invalid Prisma service token.*Request ID: req_test`),
+			},
+		},
+	})
+}
+
+// TestProjectResourceInvalidName tests that empty names fail at plan time.
+func TestProjectResourceInvalidName(t *testing.T) {
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testProjectResourceConfig(""),
+				ExpectError: regexp.MustCompile(`string length must be at least 1`),
+			},
+		},
+	})
+}
+
+// TestProjectResourceSurroundingWhitespace tests that names the API trims
+// do not show a diff after apply.
+func TestProjectResourceSurroundingWhitespace(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectResourceConfig(" test-project "),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_project.test", tfjsonpath.New("name"), knownvalue.StringExact(" test-project ")),
+				},
+			},
+			{
+				RefreshState: true,
+			},
 		},
 	})
 }
@@ -42,3 +152,72 @@ resource "prisma-postgres_project" "test" {
 }
 `
 }
+
+// TestProjectResourceNamePrefix tests that name_prefix generates a name
+// once, and that changing the prefix replaces the project.
+func TestProjectResourceNamePrefix(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectResourceNamePrefixConfig("test-"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_project.test", tfjsonpath.New("name"), knownvalue.StringRegexp(regexp.MustCompile(`^test-[a-z2-7]{8}$`))),
+				},
+			},
+			{
+				Config: testProjectResourceNamePrefixConfig("other-"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("prisma-postgres_project.test", plancheck.ResourceActionDestroyBeforeCreate),
+						plancheck.ExpectUnknownValue("prisma-postgres_project.test", tfjsonpath.New("name")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("prisma-postgres_project.test", tfjsonpath.New("name"), knownvalue.StringRegexp(regexp.MustCompile(`^other-[a-z2-7]{8}$`))),
+				},
+			},
+		},
+	})
+}
+
+// TestProjectResourceNameAndNamePrefix tests that exactly one of name and
+// name_prefix must be set.
+func TestProjectResourceNameAndNamePrefix(t *testing.T) {
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "prisma-postgres_project" "test" {
+  name        = "test-project"
+  name_prefix = "test-"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+resource "prisma-postgres_project" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testProjectResourceNamePrefixConfig(prefix string) string {
+	return `
+resource "prisma-postgres_project" "test" {
+  name_prefix = "` + prefix + `"
+}
+`
+}
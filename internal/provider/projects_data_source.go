@@ -0,0 +1,183 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ProjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ProjectsDataSource{}
+)
+
+// ProjectsDataSource defines the data source implementation.
+type ProjectsDataSource struct {
+	client *client.Client
+}
+
+// ProjectsDataSourceModel describes the data source data model.
+type ProjectsDataSourceModel struct {
+	Name     types.String           `tfsdk:"name"`
+	Projects []ProjectsProjectModel `tfsdk:"projects"`
+}
+
+// ProjectsProjectModel describes a single project.
+type ProjectsProjectModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	WorkspaceName types.String `tfsdk:"workspace_name"`
+}
+
+// NewProjectsDataSource creates a new projects data source.
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *ProjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+// Schema defines the schema for the data source.
+func (d *ProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Prisma Postgres projects in the workspace of the service token.",
+		MarkdownDescription: `
+Lists the Prisma Postgres projects in the workspace of the service token.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_projects" "all" {}
+
+# Look up a project by name
+data "prisma-postgres_projects" "shared" {
+  name = "shared"
+}
+
+output "shared_project_id" {
+  value = one(data.prisma-postgres_projects.shared.projects).id
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return projects with this name.",
+				Optional:    true,
+			},
+			"projects": schema.ListNestedAttribute{
+				Description: "List of projects matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the project.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the project.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the project was created.",
+							Computed:    true,
+						},
+						"workspace_id": schema.StringAttribute{
+							Description: "The ID of the workspace the project belongs to.",
+							Computed:    true,
+						},
+						"workspace_name": schema.StringAttribute{
+							Description: "The name of the workspace the project belongs to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state ProjectsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma projects", map[string]any{
+		"name": state.Name.ValueString(),
+	})
+
+	projects, err := d.client.ListProjects(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading projects", err),
+			"Could not list projects: "+errorDetail(err),
+		)
+		return
+	}
+
+	// The API does not support filtering, so filters are applied here.
+	state.Projects = []ProjectsProjectModel{}
+	for _, project := range projects {
+		if !state.Name.IsNull() && project.Name != state.Name.ValueString() {
+			continue
+		}
+
+		model := ProjectsProjectModel{
+			ID:            types.StringValue(project.ID),
+			Name:          types.StringValue(project.Name),
+			CreatedAt:     types.StringValue(project.CreatedAt),
+			WorkspaceID:   types.StringNull(),
+			WorkspaceName: types.StringNull(),
+		}
+
+		if project.Workspace != nil {
+			model.WorkspaceID = types.StringValue(project.Workspace.ID)
+			model.WorkspaceName = types.StringValue(project.Workspace.Name)
+		}
+
+		state.Projects = append(state.Projects, model)
+	}
+
+	tflog.Trace(ctx, "Read Prisma projects", map[string]any{
+		"count": len(state.Projects),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
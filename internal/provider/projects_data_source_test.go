@@ -0,0 +1,67 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestProjectsDataSource tests listing projects, optionally by name.
+func TestProjectsDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_projects.all", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_projects.shared", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.prisma-postgres_projects.shared", "projects.0.id",
+						"prisma-postgres_project.shared", "id",
+					),
+					resource.TestCheckResourceAttr("data.prisma-postgres_projects.shared", "projects.0.name", "shared"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_projects.shared", "projects.0.workspace_id", "wksp_test"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_projects.missing", "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testProjectsDataSourceConfig() string {
+	return `
+resource "prisma-postgres_project" "shared" {
+  name = "shared"
+}
+
+resource "prisma-postgres_project" "other" {
+  name = "other"
+}
+
+data "prisma-postgres_projects" "all" {
+  depends_on = [prisma-postgres_project.shared, prisma-postgres_project.other]
+}
+
+data "prisma-postgres_projects" "shared" {
+  name = prisma-postgres_project.shared.name
+
+  depends_on = [prisma-postgres_project.other]
+}
+
+data "prisma-postgres_projects" "missing" {
+  name = "missing"
+
+  depends_on = [prisma-postgres_project.shared, prisma-postgres_project.other]
+}
+`
+}
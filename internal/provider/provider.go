@@ -6,13 +6,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"maps"
 	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -20,7 +32,20 @@ import (
 )
 
 // Ensure the implementation satisfies the expected interfaces.
-var _ provider.Provider = &PrismaProvider{}
+var (
+	_ provider.Provider                       = &PrismaProvider{}
+	_ provider.ProviderWithEphemeralResources = &PrismaProvider{}
+	_ provider.ProviderWithFunctions          = &PrismaProvider{}
+)
+
+// readCacheTTL is how long identical API reads are de-duplicated. Terraform
+// configures a new provider instance for each operation, and the provider's
+// own writes clear the cache, so this only bounds how long changes made
+// outside of Terraform can go unnoticed during a long refresh.
+const readCacheTTL = 5 * time.Minute
+
+// headerNamePattern matches valid HTTP header names (RFC 9110 tokens).
+var headerNamePattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
 
 // PrismaProvider defines the provider implementation.
 type PrismaProvider struct {
@@ -32,7 +57,17 @@ type PrismaProvider struct {
 
 // PrismaProviderModel describes the provider data model.
 type PrismaProviderModel struct {
-	ServiceToken types.String `tfsdk:"service_token"`
+	ServiceToken        types.String `tfsdk:"service_token"`
+	NameValidationRegex types.String `tfsdk:"name_validation_regex"`
+	RequestHeaders      types.Map    `tfsdk:"request_headers"`
+}
+
+// resourceData is passed to resources. Besides the API client, it carries
+// the naming policy enforced when resources are planned, since resource
+// validators run before the provider is configured.
+type resourceData struct {
+	client      *client.Client
+	namePattern *regexp.Regexp
 }
 
 // New creates a new provider instance.
@@ -65,6 +100,20 @@ The provider requires a Prisma service token for authentication. You can provide
 2. The ` + "`PRISMA_SERVICE_TOKEN`" + ` environment variable
 
 Generate a service token from the [Prisma Console](https://console.prisma.io).
+
+## Debugging
+
+Set the ` + "`PRISMA_DEBUG_CURL`" + ` environment variable to ` + "`true`" + ` to log a cURL command for each Prisma API request at the ` + "`DEBUG`" + ` level, for example with ` + "`TF_LOG_PROVIDER=DEBUG`" + `. The commands reference the ` + "`PRISMA_SERVICE_TOKEN`" + ` environment variable instead of containing the service token, so they can be shared with Prisma support and rerun outside of Terraform.
+
+## Naming Policy
+
+Set ` + "`name_validation_regex`" + ` to enforce a naming convention for projects, databases, and connections across all modules using the provider. Plans that create or rename a resource with a name that does not match fail. Existing resources are not affected until they are renamed.
+
+` + "```hcl" + `
+provider "prisma-postgres" {
+  name_validation_regex = "^[a-z]+-(dev|staging|prod)-"
+}
+` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"service_token": schema.StringAttribute{
@@ -75,12 +124,33 @@ Generate a service token from the [Prisma Console](https://console.prisma.io).
 				Optional:  true,
 				Sensitive: true,
 			},
+			"name_validation_regex": schema.StringAttribute{
+				Description: "Regular expression (RE2 syntax) that the names of new or renamed projects, databases, and connections must match. " +
+					"Names are checked at plan time; existing resources whose names do not match are left alone.",
+				MarkdownDescription: "Regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)) that the names of new or renamed projects, databases, and connections must match, " +
+					"for example `^[a-z]+-(dev|staging|prod)-`. Names are checked at plan time; existing resources whose names do not match are left alone.",
+				Optional: true,
+			},
+			"request_headers": schema.MapAttribute{
+				Description: "Additional HTTP headers sent with every Prisma API request, such as headers required by an API gateway. " +
+					"They cannot replace the Authorization, Content-Type, Accept, or User-Agent headers.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(headerNamePattern, "must be a valid HTTP header name"),
+					),
+				},
+			},
 		},
 	}
 }
 
 // Configure prepares a Prisma API client for data sources and resources.
 func (p *PrismaProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	ctx = client.MaskSensitiveLogValues(ctx)
+
 	tflog.Info(ctx, "Configuring Prisma provider")
 
 	var config PrismaProviderModel
@@ -89,6 +159,42 @@ func (p *PrismaProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// The service token and request headers may come from resources created
+	// in the same configuration. Defer everything until they are known when
+	// Terraform supports it, rather than failing the plan.
+	headersUnknown := config.RequestHeaders.IsUnknown() ||
+		slices.ContainsFunc(slices.Collect(maps.Values(config.RequestHeaders.Elements())), attr.Value.IsUnknown)
+
+	if config.ServiceToken.IsUnknown() || headersUnknown {
+		if req.ClientCapabilities.DeferralAllowed {
+			tflog.Debug(ctx, "Deferring Prisma provider configuration until service_token and request_headers are known")
+			resp.Deferred = &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			}
+			return
+		}
+
+		if config.ServiceToken.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("service_token"),
+				"Unknown Prisma Service Token",
+				"The provider cannot create the Prisma API client as there is an unknown configuration value for the service token. "+
+					"Either apply the source of the value first, set the value statically in the configuration, "+
+					"or use the PRISMA_SERVICE_TOKEN environment variable.",
+			)
+		}
+
+		if headersUnknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_headers"),
+				"Unknown Prisma Request Headers",
+				"The provider cannot create the Prisma API client as there is an unknown configuration value for the request headers. "+
+					"Either apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
+		return
+	}
+
 	serviceToken := os.Getenv("PRISMA_SERVICE_TOKEN")
 	if !config.ServiceToken.IsNull() {
 		serviceToken = config.ServiceToken.ValueString()
@@ -105,17 +211,47 @@ func (p *PrismaProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	var namePattern *regexp.Regexp
+	if regex := config.NameValidationRegex.ValueString(); regex != "" {
+		var err error
+		namePattern, err = regexp.Compile(regex)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_validation_regex"),
+				"Invalid Name Validation Regex",
+				fmt.Sprintf("The name_validation_regex %q is not a valid regular expression: %s", regex, err),
+			)
+			return
+		}
+	}
+
+	var headers map[string]string
+	resp.Diagnostics.Append(config.RequestHeaders.ElementsAs(ctx, &headers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Allow overriding the base URL for testing.
 	baseURL := os.Getenv("PRISMA_API_BASE_URL")
 
+	// Log cURL commands reproducing each request, for sharing with support.
+	debugCurl, _ := strconv.ParseBool(os.Getenv("PRISMA_DEBUG_CURL"))
+
 	apiClient := client.NewClient(client.Config{
 		ServiceToken: serviceToken,
 		UserAgent:    "terraform-provider-prisma-postgres/" + p.version,
 		BaseURL:      baseURL,
+		DebugCurl:    debugCurl,
+		Headers:      headers,
+		ReadCacheTTL: readCacheTTL,
 	})
 
 	resp.DataSourceData = apiClient
-	resp.ResourceData = apiClient
+	resp.EphemeralResourceData = apiClient
+	resp.ResourceData = &resourceData{
+		client:      apiClient,
+		namePattern: namePattern,
+	}
 
 	tflog.Info(ctx, "Configured Prisma provider", map[string]any{"version": p.version})
 }
@@ -126,12 +262,46 @@ func (p *PrismaProvider) Resources(_ context.Context) []func() resource.Resource
 		NewProjectResource,
 		NewDatabaseResource,
 		NewConnectionResource,
+		NewConnectionSetResource,
 	}
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *PrismaProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAccelerateRegionsDataSource,
+		NewConnectionDataSource,
+		NewConnectionsDataSource,
+		NewDatabaseBackupsDataSource,
+		NewDatabaseStatusDataSource,
+		NewDatabaseUsageDataSource,
+		NewDatabasesDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
+		NewRegionDataSource,
 		NewRegionsDataSource,
+		NewWorkspaceDataSource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *PrismaProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDatabaseCredentialsEphemeralResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *PrismaProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewDatasourceBlockFunction,
+		NewDotenvFunction,
+		NewKubernetesSecretDataFunction,
+		NewNearestRegionFunction,
+		NewNormalizeRegionFunction,
+		NewPgpassLineFunction,
+		NewRedactURLFunction,
+		NewSecretsManagerJSONFunction,
+		NewURLWithParamsFunction,
 	}
 }
@@ -4,325 +4,208 @@
 package provider
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"net/http"
-	"net/http/httptest"
-	"sync"
+	"regexp"
 	"sync/atomic"
+	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
-	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+	"github.com/prisma/terraform-provider-prisma-postgres/prismatest"
 )
 
-// ID counters for generating unique resource IDs.
-var (
-	projectIDCounter    atomic.Int64
-	databaseIDCounter   atomic.Int64
-	connectionIDCounter atomic.Int64
-)
-
-func nextProjectID() string {
-	return fmt.Sprintf("proj_test%d", projectIDCounter.Add(1))
-}
-
-func nextDatabaseID() string {
-	return fmt.Sprintf("db_test%d", databaseIDCounter.Add(1))
-}
-
-func nextConnectionID() string {
-	return fmt.Sprintf("conn_test%d", connectionIDCounter.Add(1))
+// newMockAPIServer starts a fake Prisma API for a test.
+func newMockAPIServer() *prismatest.Server {
+	return prismatest.NewServer()
 }
 
-// mockAPIServer provides a configurable mock HTTP server for testing.
-type mockAPIServer struct {
-	server   *httptest.Server
-	mu       sync.RWMutex
-	handlers map[string]http.HandlerFunc
-
-	// State storage for simulating persistent resources.
-	projects    map[string]*client.Project
-	databases   map[string]*client.Database
-	connections map[string]*client.Connection
-
-	// Track last created IDs for dynamic handler registration.
-	lastProjectID    string
-	lastDatabaseID   string
-	lastConnectionID string
-}
-
-// newMockAPIServer creates a new mock API server.
-func newMockAPIServer() *mockAPIServer {
-	m := &mockAPIServer{
-		handlers:    make(map[string]http.HandlerFunc),
-		projects:    make(map[string]*client.Project),
-		databases:   make(map[string]*client.Database),
-		connections: make(map[string]*client.Connection),
+// testProtoV6ProviderFactories returns provider factories for testing.
+func testProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"prisma-postgres": providerserver.NewProtocol6WithError(New("test")()),
 	}
-
-	m.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		key := r.Method + " " + r.URL.Path
-
-		m.mu.RLock()
-		handler, ok := m.handlers[key]
-		m.mu.RUnlock()
-
-		if ok {
-			handler(w, r)
-			return
-		}
-
-		// Default 404 for unhandled routes.
-		w.WriteHeader(http.StatusNotFound)
-		_ = json.NewEncoder(w).Encode(map[string]string{"error": "not found"})
-	}))
-
-	return m
-}
-
-// URL returns the server URL.
-func (m *mockAPIServer) URL() string {
-	return m.server.URL
-}
-
-// Close shuts down the server.
-func (m *mockAPIServer) Close() {
-	m.server.Close()
 }
 
-// Handle registers a handler for a specific method and path.
-func (m *mockAPIServer) Handle(method, path string, handler http.HandlerFunc) {
-	m.mu.Lock()
-	m.handlers[method+" "+path] = handler
-	m.mu.Unlock()
-}
-
-// SetupProjectHandlers configures handlers for project CRUD operations.
-func (m *mockAPIServer) SetupProjectHandlers() {
-	// Generate ID for this test run.
-	projectID := nextProjectID()
-	m.lastProjectID = projectID
+// TestProviderConfigureUnknown tests that an unknown service token or
+// request header defers the provider when Terraform allows it and errors
+// otherwise.
+func TestProviderConfigureUnknown(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	configs := map[string]map[string]tftypes.Value{
+		"service token": {
+			"service_token":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name_validation_regex": tftypes.NewValue(tftypes.String, nil),
+			"request_headers":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		},
+		"request headers": {
+			"service_token":         tftypes.NewValue(tftypes.String, "test-token"),
+			"name_validation_regex": tftypes.NewValue(tftypes.String, nil),
+			"request_headers":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+		},
+		"request header value": {
+			"service_token":         tftypes.NewValue(tftypes.String, "test-token"),
+			"name_validation_regex": tftypes.NewValue(tftypes.String, nil),
+			"request_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"X-Client-Id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+	}
 
-	// Create project.
-	m.Handle("POST", "/v1/projects", func(w http.ResponseWriter, r *http.Request) {
-		var req client.CreateProjectRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
+	tests := []struct {
+		name             string
+		deferralAllowed  bool
+		expectedDeferred bool
+	}{
+		{name: "deferral allowed", deferralAllowed: true, expectedDeferred: true},
+		{name: "deferral not allowed", deferralAllowed: false, expectedDeferred: false},
+	}
 
-		project := &client.Project{
-			ID:        m.lastProjectID,
-			Type:      "project",
-			Name:      req.Name,
-			CreatedAt: "2025-01-07T00:00:00Z",
+	for unknown, values := range configs {
+		config := tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), values),
 		}
 
-		m.mu.Lock()
-		m.projects[project.ID] = project
-		m.mu.Unlock()
-
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(client.CreateProjectResponse{Data: *project})
-	})
-
-	// Get project.
-	m.Handle("GET", "/v1/projects/"+projectID, func(w http.ResponseWriter, r *http.Request) {
-		m.mu.RLock()
-		project, ok := m.projects[m.lastProjectID]
-		m.mu.RUnlock()
-
-		if !ok {
-			w.WriteHeader(http.StatusNotFound)
-			_ = json.NewEncoder(w).Encode(map[string]string{"error": "not found"})
-			return
+		for _, tt := range tests {
+			t.Run(unknown+"/"+tt.name, func(t *testing.T) {
+				req := provider.ConfigureRequest{
+					Config: config,
+					ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+						DeferralAllowed: tt.deferralAllowed,
+					},
+				}
+				var resp provider.ConfigureResponse
+
+				p.Configure(ctx, req, &resp)
+
+				if got := resp.Deferred != nil; got != tt.expectedDeferred {
+					t.Errorf("expected deferred %t, got %t", tt.expectedDeferred, got)
+				}
+
+				if got := resp.Diagnostics.HasError(); got == tt.expectedDeferred {
+					t.Errorf("expected error %t, got %t: %v", !tt.expectedDeferred, got, resp.Diagnostics)
+				}
+			})
 		}
-
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(client.GetProjectResponse{Data: *project})
-	})
-
-	// Delete project.
-	m.Handle("DELETE", "/v1/projects/"+projectID, func(w http.ResponseWriter, r *http.Request) {
-		m.mu.Lock()
-		delete(m.projects, m.lastProjectID)
-		m.mu.Unlock()
-		w.WriteHeader(http.StatusNoContent)
-	})
+	}
 }
 
-// SetupDatabaseHandlers configures handlers for database CRUD operations.
-func (m *mockAPIServer) SetupDatabaseHandlers() {
-	// Generate ID for this test run.
-	databaseID := nextDatabaseID()
-	m.lastDatabaseID = databaseID
-
-	// Create database.
-	m.Handle("POST", "/v1/projects/"+m.lastProjectID+"/databases", func(w http.ResponseWriter, r *http.Request) {
-		var req client.CreateDatabaseRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
-
-		region := req.Region
-		if region == "" {
-			region = "us-east-1"
-		}
-
-		database := &client.Database{
-			ID:               m.lastDatabaseID,
-			Type:             "database",
-			Name:             req.Name,
-			Status:           "ready",
-			CreatedAt:        "2025-01-07T00:00:00Z",
-			ConnectionString: "prisma://accelerate.prisma-data.net/?api_key=test_key",
-			DirectConnection: &client.DirectConnection{
-				Host: region + ".db.prisma-data.net",
-				User: "prisma_user",
-				Pass: "test_password",
+// TestProviderNameValidationRegex tests that name_validation_regex rejects
+// new and renamed resources whose names do not match, without blocking
+// existing resources.
+func TestProviderNameValidationRegex(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testProviderNameValidationRegexConfig(`[`, "legacy", "team-database"),
+				ExpectError: regexp.MustCompile(`Invalid Name Validation Regex`),
 			},
-			Region: &client.Region{
-				ID:   region,
-				Name: "Test Region",
+			{
+				Config:      testProviderNameValidationRegexConfig(`^team-`, "legacy", "team-database"),
+				ExpectError: regexp.MustCompile(`The name "legacy" does not match the provider's name_validation_regex`),
 			},
-		}
-
-		m.mu.Lock()
-		m.databases[database.ID] = database
-		m.mu.Unlock()
-
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(client.CreateDatabaseResponse{Data: *database})
-	})
-
-	// Get database.
-	m.Handle("GET", "/v1/databases/"+databaseID, func(w http.ResponseWriter, r *http.Request) {
-		m.mu.RLock()
-		database, ok := m.databases[m.lastDatabaseID]
-		project := m.projects[m.lastProjectID]
-		m.mu.RUnlock()
-
-		if !ok {
-			w.WriteHeader(http.StatusNotFound)
-			_ = json.NewEncoder(w).Encode(map[string]string{"error": "not found"})
-			return
-		}
-
-		// Note: GET doesn't return connection string or direct connection details.
-		resp := client.Database{
-			ID:        database.ID,
-			Type:      database.Type,
-			Name:      database.Name,
-			Status:    database.Status,
-			CreatedAt: database.CreatedAt,
-			Region:    database.Region,
-			Project: &client.ProjectRef{
-				ID:   project.ID,
-				Name: project.Name,
+			{
+				Config: testProviderNameValidationRegexConfig(`.*`, "legacy", "team-database"),
 			},
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(client.GetDatabaseResponse{Data: resp})
-	})
-
-	// Delete database.
-	m.Handle("DELETE", "/v1/databases/"+databaseID, func(w http.ResponseWriter, r *http.Request) {
-		m.mu.Lock()
-		delete(m.databases, m.lastDatabaseID)
-		m.mu.Unlock()
-		w.WriteHeader(http.StatusNoContent)
+			// The existing project keeps its name under a stricter policy.
+			{
+				Config: testProviderNameValidationRegexConfig(`^team-`, "legacy", "team-database"),
+			},
+			{
+				Config:      testProviderNameValidationRegexConfig(`^team-`, "legacy", "database"),
+				ExpectError: regexp.MustCompile(`The name "database" does not match the provider's name_validation_regex`),
+			},
+		},
 	})
 }
 
-// SetupConnectionHandlers configures handlers for connection CRUD operations.
-func (m *mockAPIServer) SetupConnectionHandlers() {
-	// Generate ID for this test run.
-	connectionID := nextConnectionID()
-	m.lastConnectionID = connectionID
+func testProviderNameValidationRegexConfig(regex, projectName, databaseName string) string {
+	return fmt.Sprintf(`
+provider "prisma-postgres" {
+  name_validation_regex = %q
+}
 
-	// Create connection.
-	m.Handle("POST", "/v1/databases/"+m.lastDatabaseID+"/connections", func(w http.ResponseWriter, r *http.Request) {
-		var req client.CreateConnectionRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
+resource "prisma-postgres_project" "test" {
+  name = %q
+}
 
-		connection := &client.Connection{
-			ID:               m.lastConnectionID,
-			Type:             "connection",
-			Name:             req.Name,
-			CreatedAt:        "2025-01-07T00:00:00Z",
-			ConnectionString: "prisma://accelerate.prisma-data.net/?api_key=conn_test_key",
-			Host:             "accelerate.prisma-data.net",
-			User:             "prisma",
-			Pass:             "conn_test_password",
-		}
+resource "prisma-postgres_database" "test" {
+  project_id = prisma-postgres_project.test.id
+  name       = %q
+  region     = "us-east-1"
+}
+`, regex, projectName, databaseName)
+}
 
-		m.mu.Lock()
-		m.connections[connection.ID] = connection
-		m.mu.Unlock()
+// TestProviderRequestHeaders tests that request_headers are sent with API
+// requests.
+func TestProviderRequestHeaders(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
 
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(client.CreateConnectionResponse{Data: *connection})
-	})
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
 
-	// List connections (used to find connection by ID).
-	m.Handle("GET", "/v1/databases/"+m.lastDatabaseID+"/connections", func(w http.ResponseWriter, r *http.Request) {
-		m.mu.RLock()
-		database := m.databases[m.lastDatabaseID]
-		var conns []client.Connection
-		for _, conn := range m.connections {
-			conns = append(conns, client.Connection{
-				ID:        conn.ID,
-				Type:      conn.Type,
-				Name:      conn.Name,
-				CreatedAt: conn.CreatedAt,
-				Database: &client.DatabaseRef{
-					ID:   database.ID,
-					Name: database.Name,
-				},
-			})
-		}
-		m.mu.RUnlock()
+	var costCenter atomic.Value
+	mock.Handle("GET", "/v1/regions/postgres", func(w http.ResponseWriter, r *http.Request) {
+		costCenter.Store(r.Header.Get("X-Cost-Center"))
 
 		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(client.ListConnectionsResponse{
-			Data:       conns,
-			Pagination: &client.Pagination{HasMore: false},
-		})
+		_, _ = w.Write([]byte(`{"data": []}`))
 	})
 
-	// Delete connection.
-	m.Handle("DELETE", "/v1/connections/"+connectionID, func(w http.ResponseWriter, r *http.Request) {
-		m.mu.Lock()
-		delete(m.connections, m.lastConnectionID)
-		m.mu.Unlock()
-		w.WriteHeader(http.StatusNoContent)
-	})
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "prisma-postgres" {
+  request_headers = {
+    "X Cost Center" = "1234"
+  }
 }
 
-// SetupRegionHandlers configures handlers for region data source.
-func (m *mockAPIServer) SetupRegionHandlers() {
-	m.Handle("GET", "/v1/regions/postgres", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(client.ListRegionsResponse{
-			Data: []client.Region{
-				{ID: "us-east-1", Name: "US East (N. Virginia)", Status: "available"},
-				{ID: "us-west-1", Name: "US West (N. California)", Status: "available"},
-				{ID: "eu-west-3", Name: "Europe (Paris)", Status: "available"},
+data "prisma-postgres_regions" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`must be a valid HTTP header name`),
 			},
-		})
-	})
+			{
+				Config: `
+provider "prisma-postgres" {
+  request_headers = {
+    "X-Cost-Center" = "1234"
+  }
 }
 
-// testProtoV6ProviderFactories returns provider factories for testing.
-func testProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
-	return map[string]func() (tfprotov6.ProviderServer, error){
-		"prisma-postgres": providerserver.NewProtocol6WithError(New("test")()),
-	}
+data "prisma-postgres_regions" "test" {}
+`,
+				Check: func(*terraform.State) error {
+					if got := costCenter.Load(); got != "1234" {
+						return fmt.Errorf("expected X-Cost-Center header 1234, got %v", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
 }
@@ -0,0 +1,74 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &RedactURLFunction{}
+
+// RedactURLFunction defines the function implementation.
+type RedactURLFunction struct{}
+
+// NewRedactURLFunction creates a new redact_url function.
+func NewRedactURLFunction() function.Function {
+	return &RedactURLFunction{}
+}
+
+// Metadata returns the function name.
+func (f *RedactURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "redact_url"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *RedactURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Masks the credentials in a connection URL.",
+		MarkdownDescription: `
+Masks the password and the ` + "`api_key`" + ` query parameter of a connection URL with
+` + "`****`" + `, leaving the host, path and other parameters intact.
+
+Terraform keeps the result marked as sensitive when the input is sensitive. Wrap
+it in ` + "`nonsensitive()`" + ` to display it.
+
+## Example Usage
+
+` + "```hcl" + `
+output "connection_string" {
+  value = nonsensitive(provider::prisma-postgres::redact_url(prisma-postgres_connection.api.connection_string))
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "The connection URL to redact.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run redacts the URL.
+func (f *RedactURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var url string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &url))
+	if resp.Error != nil {
+		return
+	}
+
+	redacted, err := redactURL(url)
+	if err != nil {
+		// Do not echo the parse error, it may contain the credentials.
+		resp.Error = function.NewArgumentFuncError(0, "Invalid URL: the value could not be parsed as a URL.")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, redacted))
+}
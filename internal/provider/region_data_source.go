@@ -0,0 +1,157 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &RegionDataSource{}
+	_ datasource.DataSourceWithConfigure = &RegionDataSource{}
+)
+
+// RegionDataSource defines the data source implementation.
+type RegionDataSource struct {
+	client *client.Client
+}
+
+// NewRegionDataSource creates a new region data source.
+func NewRegionDataSource() datasource.DataSource {
+	return &RegionDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *RegionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_region"
+}
+
+// Schema defines the schema for the data source.
+func (d *RegionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single available Prisma Postgres region.",
+		MarkdownDescription: `
+Looks up a single Prisma Postgres region by ID.
+
+Reading the data source fails if the region does not exist or is currently
+unavailable, which makes it suitable for validating module inputs.
+
+## Example Usage
+
+` + "```hcl" + `
+variable "region" {
+  type = string
+}
+
+data "prisma-postgres_region" "selected" {
+  id = var.region
+}
+
+resource "prisma-postgres_database" "example" {
+  project_id = prisma-postgres_project.example.id
+  name       = "production"
+  region     = data.prisma-postgres_region.selected.id
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The region identifier (e.g., us-east-1).",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The region name.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The region status.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RegionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *RegionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state RegionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma region", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	regions, err := d.client.ListRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading region", err),
+			"Could not read regions: "+errorDetail(err),
+		)
+		return
+	}
+
+	var region *client.Region
+	for i := range regions {
+		if regions[i].ID == state.ID.ValueString() {
+			region = &regions[i]
+			break
+		}
+	}
+
+	if region == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Region not found",
+			fmt.Sprintf("Region %q does not exist.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	if region.Status != "available" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Region unavailable",
+			fmt.Sprintf("Region %q exists but is currently %s.", region.ID, region.Status),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(region.Name)
+	state.Status = types.StringValue(region.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
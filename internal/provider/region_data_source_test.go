@@ -0,0 +1,50 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestRegionDataSource tests the region data source.
+func TestRegionDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionDataSourceConfig("eu-west-3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_region.test", "id", "eu-west-3"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_region.test", "name", "Europe (Paris)"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_region.test", "status", "available"),
+				),
+			},
+			{
+				Config:      testRegionDataSourceConfig("ap-southeast-1"),
+				ExpectError: regexp.MustCompile(`Region unavailable`),
+			},
+			{
+				Config:      testRegionDataSourceConfig("mars-north-1"),
+				ExpectError: regexp.MustCompile(`Region not found`),
+			},
+		},
+	})
+}
+
+func testRegionDataSourceConfig(id string) string {
+	return `
+data "prisma-postgres_region" "test" {
+  id = "` + id + `"
+}
+`
+}
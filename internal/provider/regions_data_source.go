@@ -6,9 +6,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -28,6 +31,8 @@ type RegionsDataSource struct {
 
 // RegionsDataSourceModel describes the data source data model.
 type RegionsDataSourceModel struct {
+	Status  types.String  `tfsdk:"status"`
+	IDs     types.Set     `tfsdk:"ids"`
 	Regions []RegionModel `tfsdk:"regions"`
 }
 
@@ -64,17 +69,35 @@ output "regions" {
   value = data.prisma-postgres_regions.available.regions
 }
 
+# Only consider available regions in Europe
+data "prisma-postgres_regions" "europe" {
+  status = "available"
+  ids    = ["eu-west-3", "eu-central-1"]
+}
+
 # Use a specific region
 resource "prisma-postgres_database" "example" {
   project_id = prisma-postgres_project.example.id
   name       = "production"
-  region     = data.prisma-postgres_regions.available.regions[0].id
+  region     = data.prisma-postgres_regions.europe.regions[0].id
 }
 ` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Description: "Only return regions with this status (available or unavailable).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("available", "unavailable"),
+				},
+			},
+			"ids": schema.SetAttribute{
+				Description: "Only return regions with these identifiers.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"regions": schema.ListNestedAttribute{
-				Description: "List of available regions.",
+				Description: "List of regions matching the filters.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -117,19 +140,47 @@ func (d *RegionsDataSource) Configure(_ context.Context, req datasource.Configur
 
 // Read refreshes the Terraform state with the latest data.
 func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	tflog.Debug(ctx, "Reading Prisma regions")
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state RegionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	if !state.IDs.IsNull() {
+		resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Reading Prisma regions", map[string]any{
+		"status": state.Status.ValueString(),
+		"ids":    ids,
+	})
 
 	regions, err := d.client.ListRegions(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error reading regions",
-			"Could not read regions: "+err.Error(),
+			errorSummary("Error reading regions", err),
+			"Could not read regions: "+errorDetail(err),
 		)
 		return
 	}
 
-	var state RegionsDataSourceModel
+	// The API does not support filtering, so filters are applied here.
+	state.Regions = []RegionModel{}
 	for _, region := range regions {
+		if !state.Status.IsNull() && region.Status != state.Status.ValueString() {
+			continue
+		}
+		if ids != nil && !slices.Contains(ids, region.ID) {
+			continue
+		}
+
 		state.Regions = append(state.Regions, RegionModel{
 			ID:     types.StringValue(region.ID),
 			Name:   types.StringValue(region.Name),
@@ -138,7 +189,7 @@ func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	tflog.Trace(ctx, "Read Prisma regions", map[string]any{
-		"count": len(regions),
+		"count": len(state.Regions),
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -13,7 +13,6 @@ import (
 func TestRegionsDataSource(t *testing.T) {
 	mock := newMockAPIServer()
 	defer mock.Close()
-	mock.SetupRegionHandlers()
 
 	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
 	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
@@ -24,7 +23,7 @@ func TestRegionsDataSource(t *testing.T) {
 			{
 				Config: testRegionsDataSourceConfig(),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.prisma-postgres_regions.test", "regions.#", "3"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_regions.test", "regions.#", "4"),
 					resource.TestCheckResourceAttr("data.prisma-postgres_regions.test", "regions.0.id", "us-east-1"),
 				),
 			},
@@ -32,8 +31,51 @@ func TestRegionsDataSource(t *testing.T) {
 	})
 }
 
+// TestRegionsDataSourceFilters tests filtering regions by status and ID.
+func TestRegionsDataSourceFilters(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionsDataSourceFiltersConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_regions.available", "regions.#", "3"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_regions.ids", "regions.#", "2"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_regions.ids", "regions.0.id", "us-west-1"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_regions.ids", "regions.1.id", "ap-southeast-1"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_regions.both", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_regions.both", "regions.0.id", "us-west-1"),
+				),
+			},
+		},
+	})
+}
+
 func testRegionsDataSourceConfig() string {
 	return `
 data "prisma-postgres_regions" "test" {}
 `
 }
+
+func testRegionsDataSourceFiltersConfig() string {
+	return `
+data "prisma-postgres_regions" "available" {
+  status = "available"
+}
+
+data "prisma-postgres_regions" "ids" {
+  ids = ["us-west-1", "ap-southeast-1"]
+}
+
+data "prisma-postgres_regions" "both" {
+  status = "available"
+  ids    = ["us-west-1", "ap-southeast-1"]
+}
+`
+}
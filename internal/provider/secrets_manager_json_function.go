@@ -0,0 +1,140 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SecretsManagerJSONFunction{}
+
+// SecretsManagerJSONFunction defines the function implementation.
+type SecretsManagerJSONFunction struct{}
+
+// secretsManagerPayload is the secret shape used for RDS databases by AWS
+// Secrets Manager and by GCP Secret Manager rotation templates.
+type secretsManagerPayload struct {
+	Engine   string `json:"engine"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	DBName   string `json:"dbname"`
+}
+
+// NewSecretsManagerJSONFunction creates a new secrets_manager_json function.
+func NewSecretsManagerJSONFunction() function.Function {
+	return &SecretsManagerJSONFunction{}
+}
+
+// Metadata returns the function name.
+func (f *SecretsManagerJSONFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "secrets_manager_json"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *SecretsManagerJSONFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders the direct credentials of a database or connection as a secrets manager JSON payload.",
+		MarkdownDescription: `
+Renders the direct PostgreSQL credentials of a database or connection as the
+JSON payload used for RDS database secrets. The payload has the
+` + "`engine`" + `, ` + "`host`" + `, ` + "`port`" + `, ` + "`username`" + `, ` + "`password`" + ` and ` + "`dbname`" + ` keys.
+
+Accepts a ` + "`prisma-postgres_database`" + ` or ` + "`prisma-postgres_connection`" + ` resource, or any
+object with ` + "`connection_string`" + ` and ` + "`direct_url`" + ` attributes. Fails when the direct
+URL is empty, for example for databases with ` + "`minimal_state`" + ` set.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "aws_secretsmanager_secret" "database" {
+  name = "prisma/production"
+}
+
+resource "aws_secretsmanager_secret_version" "database" {
+  secret_id     = aws_secretsmanager_secret.database.id
+  secret_string = provider::prisma-postgres::secrets_manager_json(prisma-postgres_connection.example)
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "credentials",
+				AttributeTypes: credentialsAttributeTypes,
+				Description:    "A database or connection with connection_string and direct_url attributes.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the secret payload.
+func (f *SecretsManagerJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var credentials credentialsModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &credentials))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := renderSecretsManagerJSON(credentials.DirectURL.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// renderSecretsManagerJSON returns the secret payload for a direct
+// PostgreSQL URL.
+func renderSecretsManagerJSON(directURL string) (string, error) {
+	if directURL == "" {
+		return "", fmt.Errorf("direct_url is empty, so there are no direct credentials to render")
+	}
+
+	u, err := url.Parse(directURL)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("direct_url is not a valid PostgreSQL URL")
+	}
+
+	payload := secretsManagerPayload{
+		Engine: "postgres",
+		Host:   u.Hostname(),
+		Port:   directPort,
+		DBName: strings.TrimPrefix(u.Path, "/"),
+	}
+
+	if port := u.Port(); port != "" {
+		payload.Port, err = strconv.Atoi(port)
+		if err != nil {
+			return "", fmt.Errorf("direct_url has an invalid port %q", port)
+		}
+	}
+
+	if payload.DBName == "" {
+		payload.DBName = directDatabaseName
+	}
+
+	if u.User != nil {
+		payload.Username = u.User.Username()
+		payload.Password, _ = u.User.Password()
+	}
+
+	result, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
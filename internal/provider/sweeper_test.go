@@ -0,0 +1,178 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// testAccResourcePrefix prefixes the names of resources created by
+// acceptance tests against the live API, so sweepers can find leaked ones.
+const testAccResourcePrefix = "tf-acc-test"
+
+// sweepAllRegions is the sweep region that matches databases in any region.
+const sweepAllRegions = "all"
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("prisma-postgres_connection", &resource.Sweeper{
+		Name: "prisma-postgres_connection",
+		F:    sweepConnections,
+	})
+
+	resource.AddTestSweepers("prisma-postgres_database", &resource.Sweeper{
+		Name:         "prisma-postgres_database",
+		Dependencies: []string{"prisma-postgres_connection"},
+		F:            sweepDatabases,
+	})
+
+	resource.AddTestSweepers("prisma-postgres_project", &resource.Sweeper{
+		Name:         "prisma-postgres_project",
+		Dependencies: []string{"prisma-postgres_database"},
+		F:            sweepProjects,
+	})
+}
+
+// sweeperClient returns a client for the live API configured from the
+// environment.
+func sweeperClient() (*client.Client, error) {
+	serviceToken := os.Getenv("PRISMA_SERVICE_TOKEN")
+	if serviceToken == "" {
+		return nil, fmt.Errorf("PRISMA_SERVICE_TOKEN must be set for sweepers")
+	}
+
+	return client.NewClient(client.Config{
+		ServiceToken: serviceToken,
+		UserAgent:    "terraform-provider-prisma-postgres/sweeper",
+		BaseURL:      os.Getenv("PRISMA_API_BASE_URL"),
+	}), nil
+}
+
+// sweepableDatabases returns the databases of all projects in the given
+// region, or in any region for sweepAllRegions.
+func sweepableDatabases(ctx context.Context, c *client.Client, region string) ([]client.Database, error) {
+	projects, err := c.ListProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing projects: %w", err)
+	}
+
+	var databases []client.Database
+	for _, project := range projects {
+		projectDatabases, err := c.ListDatabases(ctx, project.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing databases of project %s: %w", project.ID, err)
+		}
+
+		for _, database := range projectDatabases {
+			if region != sweepAllRegions && (database.Region == nil || database.Region.ID != region) {
+				continue
+			}
+			databases = append(databases, database)
+		}
+	}
+
+	return databases, nil
+}
+
+func sweepConnections(region string) error {
+	ctx := context.Background()
+
+	c, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	databases, err := sweepableDatabases(ctx, c, region)
+	if err != nil {
+		return err
+	}
+
+	for _, database := range databases {
+		connections, err := c.ListConnections(ctx, database.ID)
+		if err != nil {
+			return fmt.Errorf("listing connections of database %s: %w", database.ID, err)
+		}
+
+		for _, connection := range connections {
+			if !strings.HasPrefix(connection.Name, testAccResourcePrefix) {
+				continue
+			}
+
+			if err := c.DeleteConnection(ctx, connection.ID); err != nil {
+				return fmt.Errorf("deleting connection %s: %w", connection.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func sweepDatabases(region string) error {
+	ctx := context.Background()
+
+	c, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	databases, err := sweepableDatabases(ctx, c, region)
+	if err != nil {
+		return err
+	}
+
+	for _, database := range databases {
+		if !strings.HasPrefix(database.Name, testAccResourcePrefix) {
+			continue
+		}
+
+		if err := c.DeleteDatabase(ctx, database.ID); err != nil {
+			return fmt.Errorf("deleting database %s: %w", database.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func sweepProjects(region string) error {
+	// Projects span regions, so deleting one would also remove databases
+	// outside of the region being swept.
+	if region != sweepAllRegions {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	c, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	projects, err := c.ListProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("listing projects: %w", err)
+	}
+
+	for _, project := range projects {
+		if !strings.HasPrefix(project.Name, testAccResourcePrefix) {
+			continue
+		}
+
+		if err := c.DeleteProject(ctx, project.ID); err != nil {
+			return fmt.Errorf("deleting project %s: %w", project.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &URLWithParamsFunction{}
+
+// URLWithParamsFunction defines the function implementation.
+type URLWithParamsFunction struct{}
+
+// NewURLWithParamsFunction creates a new url_with_params function.
+func NewURLWithParamsFunction() function.Function {
+	return &URLWithParamsFunction{}
+}
+
+// Metadata returns the function name.
+func (f *URLWithParamsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "url_with_params"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *URLWithParamsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Sets query parameters on a connection URL.",
+		MarkdownDescription: `
+Sets query parameters such as ` + "`schema`" + ` or ` + "`connection_limit`" + ` on a Prisma or
+PostgreSQL connection URL. Parameters already in the URL, such as ` + "`api_key`" + `, are
+kept unless they are overridden. New parameters are appended in key order and
+values are escaped.
+
+## Example Usage
+
+` + "```hcl" + `
+output "database_url" {
+  value = provider::prisma-postgres::url_with_params(prisma-postgres_database.example.direct_url, {
+    schema           = "app"
+    connection_limit = 5
+  })
+  sensitive = true
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "The connection URL.",
+			},
+			function.MapParameter{
+				Name:        "params",
+				ElementType: types.StringType,
+				Description: "The query parameters to set.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run sets the query parameters.
+func (f *URLWithParamsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var url string
+	var params map[string]string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &url, &params))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := setURLParams(url, params)
+	if err != nil {
+		// Do not echo the parse error, it may contain the credentials.
+		resp.Error = function.NewArgumentFuncError(0, "Invalid URL: the value could not be parsed as a URL.")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
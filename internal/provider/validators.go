@@ -0,0 +1,183 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nameValidators returns the validators shared by the name attribute of
+// projects, databases, and connections.
+//
+// The Management API only requires names to be non-empty; it does not
+// publish length or character restrictions, so none are enforced here.
+func nameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthAtLeast(1),
+		surroundingWhitespaceValidator{},
+	}
+}
+
+// surroundingWhitespaceValidator warns about names with leading or trailing
+// whitespace, which the API trims. Plan modifiers cannot trim the value
+// themselves, since Terraform requires planned values to match the
+// configuration.
+type surroundingWhitespaceValidator struct{}
+
+func (v surroundingWhitespaceValidator) Description(_ context.Context) string {
+	return "value should not have leading or trailing whitespace"
+}
+
+func (v surroundingWhitespaceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v surroundingWhitespaceValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if trimmed := strings.TrimSpace(value); trimmed != value {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Name has surrounding whitespace",
+			fmt.Sprintf("The Prisma API trims names, so %q is stored as %q. Remove the whitespace from the configuration, "+
+				"for example with trimspace().", value, trimmed),
+		)
+	}
+}
+
+// readName returns the name read from the API, keeping the name in state
+// when it only differs by the whitespace the API trims, so untrimmed
+// configured names do not show a diff on every plan.
+func readName(current types.String, name string) types.String {
+	if strings.TrimSpace(current.ValueString()) == name {
+		return current
+	}
+
+	return types.StringValue(name)
+}
+
+// checkNamePolicy validates the planned name at p against the provider's
+// name_validation_regex when the resource is created or the name changes,
+// so existing resources are not blocked by a newly introduced policy.
+func checkNamePolicy(ctx context.Context, pattern *regexp.Regexp, req resource.ModifyPlanRequest, p path.Path, diags *diag.Diagnostics) {
+	if pattern == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, current types.String
+	diags.Append(req.Plan.GetAttribute(ctx, p, &planned)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, p, &current)...)
+	}
+
+	if planned.Equal(current) {
+		return
+	}
+
+	validateNamePolicy(pattern, p, planned, diags)
+}
+
+// validateNamePolicy adds an error if name does not match pattern. Unknown
+// names are checked once they are known.
+func validateNamePolicy(pattern *regexp.Regexp, p path.Path, name types.String, diags *diag.Diagnostics) {
+	if name.IsNull() || name.IsUnknown() || pattern.MatchString(name.ValueString()) {
+		return
+	}
+
+	diags.AddAttributeError(
+		p,
+		"Name does not match the naming policy",
+		fmt.Sprintf("The name %q does not match the provider's name_validation_regex %q.", name.ValueString(), pattern.String()),
+	)
+}
+
+// idKinds maps the prefixes of Prisma API IDs to the kind of object they
+// identify.
+var idKinds = map[string]string{
+	"proj_": "project",
+	"db_":   "database",
+	"con_":  "connection",
+	"wksp_": "workspace",
+	"itgr_": "integration",
+}
+
+// idBodyPattern matches an ID without its prefix, as published in the
+// Management API specification.
+const idBodyPattern = `([cC][^\s-]{8,}|[a-z0-9]+)`
+
+// projectIDValidator validates references to projects.
+func projectIDValidator() validator.String {
+	return newIDValidator("proj_")
+}
+
+// databaseIDValidator validates references to databases.
+func databaseIDValidator() validator.String {
+	return newIDValidator("db_")
+}
+
+// idValidator checks that a string has the shape of an ID of one kind, so a
+// reference to the wrong kind of object fails at plan time instead of with a
+// 404 at apply time. The prefix is optional, as in the API.
+type idValidator struct {
+	prefix  string
+	kind    string
+	pattern *regexp.Regexp
+}
+
+func newIDValidator(prefix string) idValidator {
+	return idValidator{
+		prefix:  prefix,
+		kind:    idKinds[prefix],
+		pattern: regexp.MustCompile(`^(` + prefix + `)?` + idBodyPattern + `$`),
+	}
+}
+
+func (v idValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a %s ID (%s...)", v.kind, v.prefix)
+}
+
+func (v idValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v idValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	for prefix, kind := range idKinds {
+		if prefix != v.prefix && strings.HasPrefix(value, prefix) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				fmt.Sprintf("Invalid %s ID", v.kind),
+				fmt.Sprintf("Attribute %s expects a %s ID (%s...), but %q is a %s ID. Check that the correct reference is used.",
+					req.Path, v.kind, v.prefix, value, kind),
+			)
+			return
+		}
+	}
+
+	if !v.pattern.MatchString(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			fmt.Sprintf("Invalid %s ID", v.kind),
+			fmt.Sprintf("Attribute %s expects a %s ID (%s...), got %q.", req.Path, v.kind, v.prefix, value),
+		)
+	}
+}
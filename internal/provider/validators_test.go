@@ -0,0 +1,179 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestIDValidator verifies that references to the wrong kind of object fail.
+func TestIDValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		expected  string
+	}{
+		{
+			name:      "project ID",
+			validator: projectIDValidator(),
+			value:     types.StringValue("proj_test1"),
+		},
+		{
+			name:      "project ID without prefix",
+			validator: projectIDValidator(),
+			value:     types.StringValue("cm0abcdefghij"),
+		},
+		{
+			name:      "database ID",
+			validator: databaseIDValidator(),
+			value:     types.StringValue("db_test1"),
+		},
+		{
+			name:      "null",
+			validator: databaseIDValidator(),
+			value:     types.StringNull(),
+		},
+		{
+			name:      "unknown",
+			validator: databaseIDValidator(),
+			value:     types.StringUnknown(),
+		},
+		{
+			name:      "database ID as project ID",
+			validator: projectIDValidator(),
+			value:     types.StringValue("db_test1"),
+			expected:  `Attribute test expects a project ID (proj_...), but "db_test1" is a database ID. Check that the correct reference is used.`,
+		},
+		{
+			name:      "connection ID as database ID",
+			validator: databaseIDValidator(),
+			value:     types.StringValue("con_cm0abcdefghij"),
+			expected:  `Attribute test expects a database ID (db_...), but "con_cm0abcdefghij" is a connection ID. Check that the correct reference is used.`,
+		},
+		{
+			name:      "malformed",
+			validator: databaseIDValidator(),
+			value:     types.StringValue("my database"),
+			expected:  `Attribute test expects a database ID (db_...), got "my database".`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			tt.validator.ValidateString(context.Background(), req, resp)
+
+			got := ""
+			if resp.Diagnostics.HasError() {
+				got = resp.Diagnostics.Errors()[0].Detail()
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestSurroundingWhitespaceValidator verifies that names the API would trim
+// produce a warning.
+func TestSurroundingWhitespaceValidator(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    types.String
+		expected string
+	}{
+		{
+			name:  "trimmed",
+			value: types.StringValue("production"),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:     "trailing whitespace",
+			value:    types.StringValue("production "),
+			expected: `The Prisma API trims names, so "production " is stored as "production". Remove the whitespace from the configuration, for example with trimspace().`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("name"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			surroundingWhitespaceValidator{}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			got := ""
+			if warnings := resp.Diagnostics.Warnings(); len(warnings) > 0 {
+				got = warnings[0].Detail()
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestReadName verifies that names differing only by trimmed whitespace
+// keep their value in state.
+func TestReadName(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  types.String
+		apiName  string
+		expected types.String
+	}{
+		{
+			name:     "unchanged",
+			current:  types.StringValue("production"),
+			apiName:  "production",
+			expected: types.StringValue("production"),
+		},
+		{
+			name:     "trimmed by the API",
+			current:  types.StringValue(" production "),
+			apiName:  "production",
+			expected: types.StringValue(" production "),
+		},
+		{
+			name:     "renamed outside of Terraform",
+			current:  types.StringValue(" production "),
+			apiName:  "staging",
+			expected: types.StringValue("staging"),
+		},
+		{
+			name:     "imported",
+			current:  types.StringNull(),
+			apiName:  "production",
+			expected: types.StringValue("production"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readName(tt.current, tt.apiName); !got.Equal(tt.expected) {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &WorkspaceDataSource{}
+	_ datasource.DataSourceWithConfigure = &WorkspaceDataSource{}
+)
+
+// WorkspaceDataSource defines the data source implementation.
+type WorkspaceDataSource struct {
+	client *client.Client
+}
+
+// WorkspaceDataSourceModel describes the data source data model.
+type WorkspaceDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// NewWorkspaceDataSource creates a new workspace data source.
+func NewWorkspaceDataSource() datasource.DataSource {
+	return &WorkspaceDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *WorkspaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+// Schema defines the schema for the data source.
+func (d *WorkspaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the Prisma workspace the configured service token belongs to.",
+		MarkdownDescription: `
+Looks up the Prisma workspace the configured service token belongs to.
+
+Service tokens are scoped to a single workspace, so ` + "`id`" + ` can usually be
+omitted. It is only required when the token can access more than one workspace.
+
+## Example Usage
+
+` + "```hcl" + `
+data "prisma-postgres_workspace" "current" {}
+
+output "workspace" {
+  value = data.prisma-postgres_workspace.current.name
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the workspace. Required if the service token can access more than one workspace.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the workspace.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the workspace was created.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *WorkspaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.client.MaskLogValues(ctx)
+
+	var state WorkspaceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Prisma workspace", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	workspaces, err := d.client.ListWorkspaces(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary("Error reading workspace", err),
+			"Could not list workspaces: "+errorDetail(err),
+		)
+		return
+	}
+
+	var workspace *client.Workspace
+	switch {
+	case !state.ID.IsNull():
+		for i := range workspaces {
+			if workspaces[i].ID == state.ID.ValueString() {
+				workspace = &workspaces[i]
+				break
+			}
+		}
+
+		if workspace == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Workspace not found",
+				fmt.Sprintf("Workspace %q does not exist or is not accessible with the configured service token.", state.ID.ValueString()),
+			)
+			return
+		}
+	case len(workspaces) == 1:
+		workspace = &workspaces[0]
+	case len(workspaces) == 0:
+		resp.Diagnostics.AddError(
+			"Workspace not found",
+			"The configured service token does not have access to any workspace.",
+		)
+		return
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Multiple workspaces found",
+			fmt.Sprintf("The configured service token can access %d workspaces. Set id to select one.", len(workspaces)),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(workspace.ID)
+	state.Name = types.StringValue(workspace.Name)
+	state.CreatedAt = types.StringValue(workspace.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestWorkspaceDataSource tests looking up the token's workspace.
+func TestWorkspaceDataSource(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "prisma-postgres_workspace" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_workspace.test", "id", "wksp_test"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_workspace.test", "name", "Test Workspace"),
+					resource.TestCheckResourceAttr("data.prisma-postgres_workspace.test", "created_at", "2025-01-07T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+// TestWorkspaceDataSourceMultiple tests selecting a workspace when the token can access several.
+func TestWorkspaceDataSourceMultiple(t *testing.T) {
+	mock := newMockAPIServer()
+	defer mock.Close()
+	mock.AddWorkspace("wksp_one", "One", "2025-01-07T00:00:00Z")
+	mock.AddWorkspace("wksp_two", "Two", "2025-01-08T00:00:00Z")
+
+	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+	t.Setenv("PRISMA_API_BASE_URL", mock.URL())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      `data "prisma-postgres_workspace" "test" {}`,
+				ExpectError: regexp.MustCompile(`Multiple workspaces found`),
+			},
+			{
+				Config: `
+data "prisma-postgres_workspace" "test" {
+  id = "wksp_two"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prisma-postgres_workspace.test", "name", "Two"),
+				),
+			},
+			{
+				Config: `
+data "prisma-postgres_workspace" "test" {
+  id = "wksp_missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`Workspace not found`),
+			},
+		},
+	})
+}
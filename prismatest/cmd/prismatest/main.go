@@ -0,0 +1,29 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command prismatest serves an in-memory fake of the Prisma Postgres
+// Management API, for running `terraform test` or other tools that cannot
+// start the fake in-process. Point the provider at it with
+// PRISMA_API_BASE_URL=http://<address>.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/prismatest"
+)
+
+func main() {
+	var address string
+
+	flag.StringVar(&address, "address", "127.0.0.1:8080", "address to listen on")
+	flag.Parse()
+
+	log.Printf("Serving fake Prisma API on http://%s", address)
+
+	if err := http.ListenAndServe(address, prismatest.New()); err != nil {
+		log.Fatal(err.Error())
+	}
+}
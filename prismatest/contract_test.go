@@ -0,0 +1,464 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package prismatest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// openAPISpecPath is the Prisma Management API OpenAPI document the client
+// and the fake are validated against.
+const openAPISpecPath = "../openapi-spec.json"
+
+// openAPISpec is the subset of an OpenAPI 3.1 document needed to validate
+// requests and responses.
+type openAPISpec struct {
+	Paths map[string]map[string]openAPIOperation `json:"paths"`
+}
+
+type openAPIOperation struct {
+	RequestBody *openAPIBody           `json:"requestBody"`
+	Responses   map[string]openAPIBody `json:"responses"`
+}
+
+type openAPIBody struct {
+	Content map[string]struct {
+		Schema jsonSchema `json:"schema"`
+	} `json:"content"`
+}
+
+// jsonSchema is a JSON Schema object as used by OpenAPI 3.1.
+type jsonSchema map[string]interface{}
+
+// loadOpenAPISpec reads the OpenAPI document.
+func loadOpenAPISpec(t *testing.T) *openAPISpec {
+	t.Helper()
+
+	data, err := os.ReadFile(openAPISpecPath)
+	if err != nil {
+		t.Fatalf("reading OpenAPI spec: %v", err)
+	}
+
+	var spec openAPISpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatalf("parsing OpenAPI spec: %v", err)
+	}
+
+	return &spec
+}
+
+// operation finds the operation for a method and request path, matching
+// path templates such as /v1/projects/{id}.
+func (s *openAPISpec) operation(method, path string) (string, *openAPIOperation) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for template, operations := range s.Paths {
+		templateSegments := strings.Split(strings.Trim(template, "/"), "/")
+		if len(templateSegments) != len(segments) {
+			continue
+		}
+
+		match := true
+		for i, segment := range templateSegments {
+			if !strings.HasPrefix(segment, "{") && segment != segments[i] {
+				match = false
+				break
+			}
+		}
+
+		if !match {
+			continue
+		}
+
+		if operation, ok := operations[strings.ToLower(method)]; ok {
+			return template, &operation
+		}
+	}
+
+	return "", nil
+}
+
+// validateJSONSchema returns the violations of value against schema. Unlike
+// plain JSON Schema, properties not declared by an object schema are
+// violations, so renamed or undocumented fields are caught.
+func validateJSONSchema(path string, schema jsonSchema, value interface{}) []string {
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		for _, alternative := range anyOf {
+			if len(validateJSONSchema(path, alternative.(map[string]interface{}), value)) == 0 {
+				return nil
+			}
+		}
+
+		return []string{fmt.Sprintf("%s: %v matches no alternative", path, value)}
+	}
+
+	if !matchesType(schema["type"], value) {
+		return []string{fmt.Sprintf("%s: expected type %v, got %T", path, schema["type"], value)}
+	}
+
+	if constant, ok := schema["const"]; ok && value != constant {
+		return []string{fmt.Sprintf("%s: expected %v, got %v", path, constant, value)}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && value != nil {
+		found := false
+		for _, allowed := range enum {
+			if value == allowed {
+				found = true
+			}
+		}
+
+		if !found {
+			return []string{fmt.Sprintf("%s: %v is not one of %v", path, value, enum)}
+		}
+	}
+
+	var violations []string
+
+	switch value := value.(type) {
+	case string:
+		if pattern, ok := schema["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(value) {
+			violations = append(violations, fmt.Sprintf("%s: %q does not match %s", path, value, pattern))
+		}
+
+		if schema["format"] == "date-time" {
+			if _, err := time.Parse(time.RFC3339, value); err != nil {
+				violations = append(violations, fmt.Sprintf("%s: %q is not a date-time", path, value))
+			}
+		}
+	case float64:
+		if minimum, ok := schema["minimum"].(float64); ok && value < minimum {
+			violations = append(violations, fmt.Sprintf("%s: %v is less than %v", path, value, minimum))
+		}
+
+		if maximum, ok := schema["maximum"].(float64); ok && value > maximum {
+			violations = append(violations, fmt.Sprintf("%s: %v is greater than %v", path, value, maximum))
+		}
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range value {
+				violations = append(violations, validateJSONSchema(path+"."+strconv.Itoa(i), items, item)...)
+			}
+		}
+	case map[string]interface{}:
+		properties, _ := schema["properties"].(map[string]interface{})
+
+		required, _ := schema["required"].([]interface{})
+		for _, name := range required {
+			if _, ok := value[name.(string)]; !ok {
+				violations = append(violations, fmt.Sprintf("%s: missing required property %q", path, name))
+			}
+		}
+
+		names := make([]string, 0, len(value))
+		for name := range value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			property, ok := properties[name].(map[string]interface{})
+			if !ok {
+				violations = append(violations, fmt.Sprintf("%s: undocumented property %q", path, name))
+				continue
+			}
+
+			violations = append(violations, validateJSONSchema(path+"."+name, property, value[name])...)
+		}
+	}
+
+	return violations
+}
+
+// matchesType reports whether value has one of the JSON Schema types, given
+// as a string or a list of strings. A missing type matches any value.
+func matchesType(schemaType interface{}, value interface{}) bool {
+	var types []interface{}
+	switch schemaType := schemaType.(type) {
+	case nil:
+		return true
+	case string:
+		types = []interface{}{schemaType}
+	case []interface{}:
+		types = schemaType
+	}
+
+	for _, t := range types {
+		switch t {
+		case "null":
+			if value == nil {
+				return true
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "number":
+			if _, ok := value.(float64); ok {
+				return true
+			}
+		case "integer":
+			if n, ok := value.(float64); ok && n == math.Trunc(n) {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]interface{}); ok {
+				return true
+			}
+		case "object":
+			if _, ok := value.(map[string]interface{}); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// contractTransport validates every request and response against the
+// OpenAPI document and records the operations exercised.
+type contractTransport struct {
+	t    *testing.T
+	spec *openAPISpec
+
+	mu         sync.Mutex
+	operations map[string]bool
+}
+
+func (c *contractTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	template, operation := c.spec.operation(req.Method, req.URL.Path)
+	if operation == nil {
+		c.t.Errorf("%s %s: operation not in OpenAPI spec", req.Method, req.URL.Path)
+		return http.DefaultTransport.RoundTrip(req)
+	}
+
+	name := req.Method + " " + template
+
+	c.mu.Lock()
+	c.operations[name] = true
+	c.mu.Unlock()
+
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		if operation.RequestBody == nil {
+			c.t.Errorf("%s: request body not in OpenAPI spec", name)
+		} else {
+			c.validate(name+" request", operation.RequestBody.Content["application/json"].Schema, body)
+		}
+	}
+
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, ok := operation.Responses[strconv.Itoa(resp.StatusCode)]
+	if !ok {
+		c.t.Errorf("%s: response status %d not in OpenAPI spec", name, resp.StatusCode)
+		return resp, nil
+	}
+
+	content, ok := response.Content["application/json"]
+	if !ok {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.validate(fmt.Sprintf("%s response %d", name, resp.StatusCode), content.Schema, body)
+
+	return resp, nil
+}
+
+func (c *contractTransport) validate(name string, schema jsonSchema, body []byte) {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		c.t.Errorf("%s: invalid JSON: %v", name, err)
+		return
+	}
+
+	for _, violation := range validateJSONSchema("$", schema, value) {
+		c.t.Errorf("%s: %s", name, violation)
+	}
+}
+
+// TestContract exercises every client method against the fake and validates
+// the requests and responses against the OpenAPI document, so that drift
+// between the client, the fake, and the live API fails the build.
+func TestContract(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	transport := &contractTransport{
+		t:          t,
+		spec:       loadOpenAPISpec(t),
+		operations: make(map[string]bool),
+	}
+
+	ctx := context.Background()
+	c := client.NewClient(client.Config{
+		ServiceToken: "test-token",
+		BaseURL:      s.URL(),
+		HTTPClient:   &http.Client{Transport: transport},
+	})
+
+	steps := []struct {
+		name string
+		call func() error
+	}{
+		{name: "list workspaces", call: func() error { _, err := c.ListWorkspaces(ctx); return err }},
+		{name: "list regions", call: func() error { _, err := c.ListRegions(ctx); return err }},
+		{name: "list accelerate regions", call: func() error { _, err := c.ListAccelerateRegions(ctx); return err }},
+		{name: "create project", call: func() error { _, err := c.CreateProject(ctx, "test-project", false); return err }},
+		{name: "create project with database", call: func() error { _, err := c.CreateProject(ctx, "default-project", true); return err }},
+		{name: "get project", call: func() error { _, err := c.GetProject(ctx, "proj_test1"); return err }},
+		{name: "list projects", call: func() error { _, err := c.ListProjects(ctx); return err }},
+		{name: "create database", call: func() error { _, err := c.CreateDatabase(ctx, "proj_test1", "test-database", "eu-west-3"); return err }},
+		{name: "restore database", call: func() error {
+			_, err := c.RestoreDatabase(ctx, "proj_test1", "restored", "", "db_test2", BackupID)
+			return err
+		}},
+		{name: "get database", call: func() error { _, err := c.GetDatabase(ctx, "db_test3"); return err }},
+		{name: "list databases", call: func() error { _, err := c.ListDatabases(ctx, "proj_test1"); return err }},
+		{name: "list backups", call: func() error { _, err := c.ListBackups(ctx, "db_test2", 5); return err }},
+		{name: "get usage", call: func() error {
+			_, err := c.GetDatabaseUsage(ctx, "db_test2", "2025-01-01T00:00:00Z", "2025-01-31T00:00:00Z")
+			return err
+		}},
+		{name: "create connection", call: func() error { _, err := c.CreateConnection(ctx, "db_test2", "test-connection"); return err }},
+		{name: "list connections", call: func() error { _, err := c.ListConnections(ctx, "db_test2"); return err }},
+		{name: "delete connection", call: func() error { return c.DeleteConnection(ctx, "con_test1") }},
+		{name: "delete database", call: func() error { return c.DeleteDatabase(ctx, "db_test3") }},
+		{name: "delete project", call: func() error { return c.DeleteProject(ctx, "proj_test1") }},
+		{name: "get missing project", call: func() error {
+			_, err := c.GetProject(ctx, "proj_test1")
+			if apiErr, ok := err.(*client.APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return fmt.Errorf("expected not found error, got %v", err)
+		}},
+	}
+
+	for _, step := range steps {
+		if err := step.call(); err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+	}
+
+	// Every operation the provider uses must have been exercised.
+	for _, expected := range []string{
+		"DELETE /v1/connections/{id}",
+		"DELETE /v1/databases/{databaseId}",
+		"DELETE /v1/projects/{id}",
+		"GET /v1/databases/{databaseId}",
+		"GET /v1/databases/{databaseId}/backups",
+		"GET /v1/databases/{databaseId}/connections",
+		"GET /v1/databases/{databaseId}/usage",
+		"GET /v1/projects",
+		"GET /v1/projects/{id}",
+		"GET /v1/projects/{projectId}/databases",
+		"GET /v1/regions/accelerate",
+		"GET /v1/regions/postgres",
+		"GET /v1/workspaces",
+		"POST /v1/databases/{databaseId}/connections",
+		"POST /v1/projects",
+		"POST /v1/projects/{projectId}/databases",
+	} {
+		if !transport.operations[expected] {
+			t.Errorf("expected operation %s to be exercised", expected)
+		}
+	}
+}
+
+// TestValidateJSONSchema verifies that the validator catches schema drift.
+func TestValidateJSONSchema(t *testing.T) {
+	schema := jsonSchema{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id":     map[string]interface{}{"type": "string", "pattern": "^(db_)?[a-z0-9]+$"},
+			"status": map[string]interface{}{"type": "string", "enum": []interface{}{"ready", "failure"}},
+			"size":   map[string]interface{}{"type": []interface{}{"integer", "null"}},
+		},
+		"required": []interface{}{"id"},
+	}
+
+	tests := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "valid",
+			body: `{"id": "db_abc", "status": "ready", "size": null}`,
+		},
+		{
+			name:     "missing required property",
+			body:     `{"status": "ready"}`,
+			expected: []string{`$: missing required property "id"`},
+		},
+		{
+			name:     "undocumented property",
+			body:     `{"id": "db_abc", "databaseStatus": "ready"}`,
+			expected: []string{`$: undocumented property "databaseStatus"`},
+		},
+		{
+			name:     "enum mismatch",
+			body:     `{"id": "db_abc", "status": "deleted"}`,
+			expected: []string{`$.status: deleted is not one of [ready failure]`},
+		},
+		{
+			name:     "pattern mismatch",
+			body:     `{"id": "database-1"}`,
+			expected: []string{`$.id: "database-1" does not match ^(db_)?[a-z0-9]+$`},
+		},
+		{
+			name:     "type mismatch",
+			body:     `{"id": "db_abc", "size": 1.5}`,
+			expected: []string{`$.size: expected type [integer null], got float64`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value interface{}
+			if err := json.Unmarshal([]byte(tt.body), &value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := validateJSONSchema("$", schema, value)
+			if strings.Join(got, "\n") != strings.Join(tt.expected, "\n") {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
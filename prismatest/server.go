@@ -0,0 +1,835 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package prismatest provides an in-memory fake of the Prisma Postgres
+// Management API for testing Terraform configurations that use the
+// prisma-postgres provider.
+//
+// The fake keeps projects, databases, and connections in memory and serves
+// the same endpoints as the live API, so configurations can be planned,
+// applied, and destroyed without a service token or billable resources.
+// Point the provider at it with the PRISMA_API_BASE_URL environment
+// variable:
+//
+//	server := prismatest.NewServer()
+//	defer server.Close()
+//
+//	t.Setenv("PRISMA_SERVICE_TOKEN", "test-token")
+//	t.Setenv("PRISMA_API_BASE_URL", server.URL())
+//
+// Any non-empty service token is accepted.
+package prismatest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+const (
+	// CreatedAt is the creation timestamp of all resources created by the fake.
+	CreatedAt = "2025-01-07T00:00:00Z"
+
+	// DefaultWorkspaceID is the ID of the workspace the fake starts with.
+	// Projects are created in this workspace.
+	DefaultWorkspaceID = "wksp_test"
+
+	// DefaultWorkspaceName is the name of the workspace the fake starts with.
+	DefaultWorkspaceName = "Test Workspace"
+
+	// DefaultRegion is the region of databases created without one.
+	DefaultRegion = "us-east-1"
+
+	// defaultPageSize is the page size of list endpoints without a limit.
+	defaultPageSize = 100
+
+	// defaultBackupsLimit is the number of backups listed without a limit.
+	defaultBackupsLimit = 25
+)
+
+// Database statuses reported by the API.
+const (
+	StatusFailure      = "failure"
+	StatusProvisioning = "provisioning"
+	StatusReady        = "ready"
+	StatusRecovering   = "recovering"
+)
+
+// Credentials returned when creating databases and connections.
+const (
+	DatabaseAPIKey     = "test_key"
+	DatabaseUser       = "prisma_user"
+	DatabasePassword   = "test_password"
+	ConnectionAPIKey   = "conn_test_key"
+	ConnectionHost     = "accelerate.prisma-data.net"
+	ConnectionUser     = "prisma"
+	ConnectionPassword = "conn_test_password"
+)
+
+// BackupID is the ID of the backup the fake reports for every database.
+const BackupID = "bkp_test1"
+
+// regions are the Postgres regions served by the fake. One region is
+// unavailable so that region validation can be tested.
+var regions = []client.Region{
+	{ID: "us-east-1", Type: "region", Name: "US East (N. Virginia)", Status: "available"},
+	{ID: "us-west-1", Type: "region", Name: "US West (N. California)", Status: "available"},
+	{ID: "eu-west-3", Type: "region", Name: "Europe (Paris)", Status: "available"},
+	{ID: "ap-southeast-1", Type: "region", Name: "Asia Pacific (Singapore)", Status: "unavailable"},
+}
+
+// accelerateRegions are the Accelerate regions served by the fake.
+var accelerateRegions = []client.Region{
+	{ID: "us-east-1", Type: "region", Name: "US East (N. Virginia)"},
+	{ID: "us-west-1", Type: "region", Name: "US West (N. California)"},
+	{ID: "eu-central-1", Type: "region", Name: "Europe (Frankfurt)"},
+	{ID: "ap-southeast-1", Type: "region", Name: "Asia Pacific (Singapore)"},
+}
+
+// database is a database stored by the fake along with its project.
+type database struct {
+	client.Database
+	projectID string
+}
+
+// connection is a connection stored by the fake along with its database.
+type connection struct {
+	client.Connection
+	databaseID string
+}
+
+// dataResponse is the envelope of single-resource responses.
+type dataResponse struct {
+	Data interface{} `json:"data"`
+}
+
+// pagination is the pagination of list responses. Unlike client.Pagination,
+// nextCursor is always present, as in the live API.
+type pagination struct {
+	NextCursor *string `json:"nextCursor"`
+	HasMore    bool    `json:"hasMore"`
+}
+
+type listResponse[T any] struct {
+	Data       []T        `json:"data"`
+	Pagination pagination `json:"pagination"`
+}
+
+type backupsResponse struct {
+	Data       []client.Backup    `json:"data"`
+	Meta       client.BackupsMeta `json:"meta"`
+	Pagination struct {
+		HasMore bool `json:"hasMore"`
+		Limit   int  `json:"limit"`
+	} `json:"pagination"`
+}
+
+// createdProject is a project as returned on create, with its default
+// database or null.
+type createdProject struct {
+	client.Project
+	Database *createdDatabase `json:"database"`
+}
+
+// createdDatabase is a database as returned on create, with its API keys.
+type createdDatabase struct {
+	client.Database
+	APIKeys []client.APIKey `json:"apiKeys"`
+}
+
+// Server is a stateful fake of the Prisma Postgres Management API. It is safe
+// for concurrent use.
+type Server struct {
+	server *httptest.Server
+
+	mu        sync.Mutex
+	overrides map[string]http.HandlerFunc
+	requests  map[string]int
+
+	workspaces  []client.Workspace
+	projects    []*client.Project
+	databases   []*database
+	connections []*connection
+
+	projectCounter    int
+	databaseCounter   int
+	connectionCounter int
+
+	databaseCreateStatus string
+}
+
+// New returns a fake that is not listening on any address. Serve it with any
+// http.Server, or use NewServer for a server on a local port.
+func New() *Server {
+	return &Server{
+		overrides: make(map[string]http.HandlerFunc),
+		requests:  make(map[string]int),
+		workspaces: []client.Workspace{
+			{ID: DefaultWorkspaceID, Type: "workspace", Name: DefaultWorkspaceName, CreatedAt: CreatedAt},
+		},
+	}
+}
+
+// NewServer returns a fake listening on a local port. Close it when done.
+func NewServer() *Server {
+	s := New()
+	s.server = httptest.NewServer(s)
+
+	return s
+}
+
+// URL returns the base URL of a fake started with NewServer.
+func (s *Server) URL() string {
+	if s.server == nil {
+		return ""
+	}
+
+	return s.server.URL
+}
+
+// Close shuts down a fake started with NewServer.
+func (s *Server) Close() {
+	if s.server != nil {
+		s.server.Close()
+	}
+}
+
+// Handle overrides the handler for a method and exact path, such as
+// "POST" and "/v1/projects", for example to simulate API errors.
+func (s *Server) Handle(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	s.overrides[method+" "+path] = handler
+	s.mu.Unlock()
+}
+
+// Requests returns the number of requests received for a method and exact
+// path, such as "GET" and "/v1/projects/proj_test1".
+func (s *Server) Requests(method, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[method+" "+path]
+}
+
+// ResetRequests resets the request counts returned by Requests.
+func (s *Server) ResetRequests() {
+	s.mu.Lock()
+	s.requests = make(map[string]int)
+	s.mu.Unlock()
+}
+
+// AddWorkspace adds a workspace visible to the service token.
+func (s *Server) AddWorkspace(id, name, createdAt string) {
+	s.mu.Lock()
+	s.workspaces = append(s.workspaces, client.Workspace{ID: id, Type: "workspace", Name: name, CreatedAt: createdAt})
+	s.mu.Unlock()
+}
+
+// AddConnection adds a connection to a database, as if it had been created
+// outside of Terraform.
+func (s *Server) AddConnection(databaseID, id, name, createdAt string) {
+	s.mu.Lock()
+	s.connections = append(s.connections, &connection{
+		Connection: client.Connection{ID: id, Type: "connection", Name: name, CreatedAt: createdAt},
+		databaseID: databaseID,
+	})
+	s.mu.Unlock()
+}
+
+// ConnectionIDs returns the IDs of all connections.
+func (s *Server) ConnectionIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(s.connections))
+	for _, conn := range s.connections {
+		ids = append(ids, conn.ID)
+	}
+
+	return ids
+}
+
+// SetConnectionCreatedAt changes the creation timestamp of a connection, as
+// if it had been recreated outside of Terraform.
+func (s *Server) SetConnectionCreatedAt(id, createdAt string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, conn := range s.connections {
+		if conn.ID == id {
+			conn.CreatedAt = createdAt
+		}
+	}
+}
+
+// SetDatabaseCreateStatus sets the status of databases created from now on,
+// for example StatusFailure to simulate failed provisioning. By default new
+// databases are ready, and restored databases are recovering until read.
+func (s *Server) SetDatabaseCreateStatus(status string) {
+	s.mu.Lock()
+	s.databaseCreateStatus = status
+	s.mu.Unlock()
+}
+
+// ServeHTTP serves the Prisma Postgres Management API.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests[r.Method+" "+r.URL.Path]++
+	override, ok := s.overrides[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	if ok {
+		override(w, r)
+		return
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if token := strings.TrimPrefix(authorization, "Bearer "); token == "" || token == authorization {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid service token")
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "v1" {
+		writeNotFound(w)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch route := segments[1:]; {
+	case r.Method == http.MethodGet && matchRoute(route, "workspaces"):
+		s.listWorkspaces(w, r)
+	case r.Method == http.MethodGet && matchRoute(route, "regions", "postgres"):
+		writeJSON(w, http.StatusOK, client.ListRegionsResponse{Data: regions})
+	case r.Method == http.MethodGet && matchRoute(route, "regions", "accelerate"):
+		writeJSON(w, http.StatusOK, client.ListRegionsResponse{Data: accelerateRegions})
+	case r.Method == http.MethodGet && matchRoute(route, "projects"):
+		s.listProjects(w, r)
+	case r.Method == http.MethodPost && matchRoute(route, "projects"):
+		s.createProject(w, r)
+	case r.Method == http.MethodGet && matchRoute(route, "projects", "*"):
+		s.getProject(w, route[1])
+	case r.Method == http.MethodDelete && matchRoute(route, "projects", "*"):
+		s.deleteProject(w, route[1])
+	case r.Method == http.MethodGet && matchRoute(route, "projects", "*", "databases"):
+		s.listDatabases(w, r, route[1])
+	case r.Method == http.MethodPost && matchRoute(route, "projects", "*", "databases"):
+		s.createDatabase(w, r, route[1])
+	case r.Method == http.MethodGet && matchRoute(route, "databases", "*"):
+		s.getDatabase(w, route[1])
+	case r.Method == http.MethodDelete && matchRoute(route, "databases", "*"):
+		s.deleteDatabase(w, route[1])
+	case r.Method == http.MethodGet && matchRoute(route, "databases", "*", "backups"):
+		s.listBackups(w, r, route[1])
+	case r.Method == http.MethodGet && matchRoute(route, "databases", "*", "usage"):
+		s.getDatabaseUsage(w, r, route[1])
+	case r.Method == http.MethodGet && matchRoute(route, "databases", "*", "connections"):
+		s.listConnections(w, r, route[1])
+	case r.Method == http.MethodPost && matchRoute(route, "databases", "*", "connections"):
+		s.createConnection(w, r, route[1])
+	case r.Method == http.MethodDelete && matchRoute(route, "connections", "*"):
+		s.deleteConnection(w, route[1])
+	default:
+		writeNotFound(w)
+	}
+}
+
+// matchRoute reports whether the path segments match the pattern, where "*"
+// matches any single segment.
+func matchRoute(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+
+	for i, p := range pattern {
+		if p != "*" && p != segments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Server) listWorkspaces(w http.ResponseWriter, r *http.Request) {
+	writePage(w, r, s.workspaces)
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	projects := make([]client.Project, 0, len(s.projects))
+	for _, project := range s.projects {
+		projects = append(projects, *project)
+	}
+
+	writePage(w, r, projects)
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateProjectRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	// Like the API, names are stored without surrounding whitespace.
+	req.Name = strings.TrimSpace(req.Name)
+	if err != nil || req.Name == "" {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "A project name is required")
+		return
+	}
+
+	s.projectCounter++
+	project := &client.Project{
+		ID:        fmt.Sprintf("proj_test%d", s.projectCounter),
+		Type:      "project",
+		Name:      req.Name,
+		CreatedAt: CreatedAt,
+		Workspace: &client.WorkspaceRef{
+			ID:   s.workspaces[0].ID,
+			Name: s.workspaces[0].Name,
+		},
+	}
+	s.projects = append(s.projects, project)
+
+	resp := createdProject{Project: *project}
+	if req.CreateDatabase {
+		database := s.newDatabase(project.ID, req.Name, DefaultRegion, StatusReady)
+		database.IsDefault = true
+		resp.Database = s.created(database)
+		resp.Database.Project = nil
+	}
+
+	writeJSON(w, http.StatusCreated, dataResponse{Data: resp})
+}
+
+func (s *Server) getProject(w http.ResponseWriter, id string) {
+	project := s.project(id)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, client.GetProjectResponse{Data: *project})
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, id string) {
+	if s.project(id) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	// Deleting a project deletes its databases and their connections.
+	var databaseIDs []string
+	for _, database := range s.databases {
+		if database.projectID == id {
+			databaseIDs = append(databaseIDs, database.ID)
+		}
+	}
+
+	for _, databaseID := range databaseIDs {
+		s.removeDatabase(databaseID)
+	}
+
+	s.projects = removeWhere(s.projects, func(p *client.Project) bool { return p.ID == id })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listDatabases(w http.ResponseWriter, r *http.Request, projectID string) {
+	if s.project(projectID) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var databases []client.Database
+	for _, database := range s.databases {
+		if database.projectID == projectID {
+			databases = append(databases, s.read(database))
+		}
+	}
+
+	writePage(w, r, databases)
+}
+
+func (s *Server) createDatabase(w http.ResponseWriter, r *http.Request, projectID string) {
+	if s.project(projectID) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var req client.CreateDatabaseRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	req.Name = strings.TrimSpace(req.Name)
+	if err != nil || req.Name == "" {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "A database name is required")
+		return
+	}
+
+	region := req.Region
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	if !regionAvailable(region) {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", fmt.Sprintf("Region %s is not available", region))
+		return
+	}
+
+	status := StatusReady
+	if req.FromDatabase != nil {
+		source := s.database(req.FromDatabase.ID)
+		if source == nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", fmt.Sprintf("Source database %s not found", req.FromDatabase.ID))
+			return
+		}
+
+		if req.FromDatabase.BackupID != "" && req.FromDatabase.BackupID != BackupID {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", fmt.Sprintf("Backup %s not found", req.FromDatabase.BackupID))
+			return
+		}
+
+		// Restored databases recover until they are first read.
+		status = StatusRecovering
+	}
+
+	if s.databaseCreateStatus != "" {
+		status = s.databaseCreateStatus
+	}
+
+	database := s.newDatabase(projectID, req.Name, region, status)
+	database.IsDefault = req.IsDefault
+
+	writeJSON(w, http.StatusCreated, dataResponse{Data: s.created(database)})
+}
+
+func (s *Server) getDatabase(w http.ResponseWriter, id string) {
+	database := s.database(id)
+	if database == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, client.GetDatabaseResponse{Data: s.read(database)})
+}
+
+func (s *Server) deleteDatabase(w http.ResponseWriter, id string) {
+	if s.database(id) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.removeDatabase(id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listBackups(w http.ResponseWriter, r *http.Request, databaseID string) {
+	if s.database(databaseID) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	limit, ok := parseLimit(w, r, defaultBackupsLimit)
+	if !ok {
+		return
+	}
+
+	backups := []client.Backup{
+		{ID: BackupID, Type: "backup", BackupType: "full", CreatedAt: CreatedAt, Size: 1024, Status: "completed"},
+	}
+	if len(backups) > limit {
+		backups = backups[:limit]
+	}
+
+	resp := backupsResponse{
+		Data: backups,
+		Meta: client.BackupsMeta{BackupRetentionDays: 7},
+	}
+	resp.Pagination.Limit = limit
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// getDatabaseUsage reports fixed metrics and echoes the requested window as
+// the period.
+func (s *Server) getDatabaseUsage(w http.ResponseWriter, r *http.Request, databaseID string) {
+	if s.database(databaseID) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	start := r.URL.Query().Get("startDate")
+	if start == "" {
+		start = "2025-01-01T00:00:00Z"
+	}
+	end := r.URL.Query().Get("endDate")
+	if end == "" {
+		end = CreatedAt
+	}
+
+	writeJSON(w, http.StatusOK, client.DatabaseUsage{
+		Period: client.UsagePeriod{Start: start, End: end},
+		Metrics: client.UsageMetrics{
+			Operations: client.UsageMetric{Used: 1200, Unit: "ops"},
+			Storage:    client.UsageMetric{Used: 0.5, Unit: "GiB"},
+		},
+		GeneratedAt: CreatedAt,
+	})
+}
+
+func (s *Server) listConnections(w http.ResponseWriter, r *http.Request, databaseID string) {
+	database := s.database(databaseID)
+	if database == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var connections []client.Connection
+	for _, conn := range s.connections {
+		if conn.databaseID != databaseID {
+			continue
+		}
+
+		connections = append(connections, client.Connection{
+			ID:        conn.ID,
+			Type:      conn.Type,
+			Name:      conn.Name,
+			CreatedAt: conn.CreatedAt,
+			Database:  &client.DatabaseRef{ID: database.ID, Name: database.Name},
+		})
+	}
+
+	writePage(w, r, connections)
+}
+
+// createConnection creates a connection with a new ID on every call, so that
+// rotations can be observed.
+func (s *Server) createConnection(w http.ResponseWriter, r *http.Request, databaseID string) {
+	database := s.database(databaseID)
+	if database == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var req client.CreateConnectionRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	req.Name = strings.TrimSpace(req.Name)
+	if err != nil || req.Name == "" {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "A connection name is required")
+		return
+	}
+
+	if database.Status != StatusReady {
+		writeError(w, http.StatusConflict, "DATABASE_NOT_READY", fmt.Sprintf("Database %s is not ready", databaseID))
+		return
+	}
+
+	s.connectionCounter++
+	conn := &connection{
+		Connection: client.Connection{
+			ID:        fmt.Sprintf("con_test%d", s.connectionCounter),
+			Type:      "connection",
+			Name:      req.Name,
+			CreatedAt: CreatedAt,
+		},
+		databaseID: databaseID,
+	}
+	s.connections = append(s.connections, conn)
+
+	resp := conn.Connection
+	resp.Database = &client.DatabaseRef{ID: database.ID, Name: database.Name}
+	resp.ConnectionString = "prisma://" + ConnectionHost + "/?api_key=" + ConnectionAPIKey
+	resp.Host = ConnectionHost
+	resp.User = ConnectionUser
+	resp.Pass = ConnectionPassword
+
+	writeJSON(w, http.StatusOK, client.CreateConnectionResponse{Data: resp})
+}
+
+func (s *Server) deleteConnection(w http.ResponseWriter, id string) {
+	before := len(s.connections)
+	s.connections = removeWhere(s.connections, func(c *connection) bool { return c.ID == id })
+
+	if len(s.connections) == before {
+		writeNotFound(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) project(id string) *client.Project {
+	for _, project := range s.projects {
+		if project.ID == id {
+			return project
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) database(id string) *database {
+	for _, database := range s.databases {
+		if database.ID == id {
+			return database
+		}
+	}
+
+	return nil
+}
+
+// newDatabase stores a new database with credentials, as returned on create.
+func (s *Server) newDatabase(projectID, name, region, status string) *database {
+	s.databaseCounter++
+	db := &database{
+		Database: client.Database{
+			ID:               fmt.Sprintf("db_test%d", s.databaseCounter),
+			Type:             "database",
+			Name:             name,
+			Status:           status,
+			CreatedAt:        CreatedAt,
+			Region:           regionRef(region),
+			ConnectionString: "prisma://" + ConnectionHost + "/?api_key=" + DatabaseAPIKey,
+			DirectConnection: &client.DirectConnection{
+				Host: region + ".db.prisma-data.net",
+				User: DatabaseUser,
+				Pass: DatabasePassword,
+			},
+		},
+		projectID: projectID,
+	}
+	s.databases = append(s.databases, db)
+
+	return db
+}
+
+// created returns the database as returned on create, including credentials.
+// Restored databases are reported as provisioning until they are read.
+func (s *Server) created(d *database) *createdDatabase {
+	resp := &createdDatabase{Database: d.Database, APIKeys: []client.APIKey{}}
+
+	if project := s.project(d.projectID); project != nil {
+		resp.Project = &client.ProjectRef{ID: project.ID, Name: project.Name}
+	}
+
+	if resp.Status == StatusRecovering {
+		resp.Status = StatusProvisioning
+	}
+
+	return resp
+}
+
+// read returns the database as returned by GET, without credentials. Reading
+// a recovering or provisioning database completes its recovery.
+func (s *Server) read(d *database) client.Database {
+	resp := d.Database
+	resp.ConnectionString = ""
+	resp.DirectConnection = nil
+
+	if project := s.project(d.projectID); project != nil {
+		resp.Project = &client.ProjectRef{ID: project.ID, Name: project.Name}
+	}
+
+	if d.Status == StatusRecovering || d.Status == StatusProvisioning {
+		d.Status = StatusReady
+	}
+
+	return resp
+}
+
+// removeDatabase deletes a database and its connections.
+func (s *Server) removeDatabase(id string) {
+	s.connections = removeWhere(s.connections, func(c *connection) bool { return c.databaseID == id })
+	s.databases = removeWhere(s.databases, func(d *database) bool { return d.ID == id })
+}
+
+func regionRef(id string) *client.Region {
+	for _, region := range regions {
+		if region.ID == id {
+			return &client.Region{ID: region.ID, Name: region.Name}
+		}
+	}
+
+	return &client.Region{ID: id, Name: id}
+}
+
+func regionAvailable(id string) bool {
+	for _, region := range regions {
+		if region.ID == id {
+			return region.Status == "available"
+		}
+	}
+
+	return false
+}
+
+func removeWhere[T any](items []T, match func(T) bool) []T {
+	kept := items[:0]
+	for _, item := range items {
+		if !match(item) {
+			kept = append(kept, item)
+		}
+	}
+
+	return kept
+}
+
+// writePage writes the page of items selected by the cursor and limit query
+// parameters. Cursors are offsets into the list.
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	limit, ok := parseLimit(w, r, defaultPageSize)
+	if !ok {
+		return
+	}
+
+	offset := 0
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		var err error
+		offset, err = strconv.Atoi(cursor)
+		if err != nil || offset < 0 || offset > len(items) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid cursor")
+			return
+		}
+	}
+
+	resp := listResponse[T]{Data: append([]T{}, items[offset:]...)}
+	if end := offset + limit; end < len(items) {
+		nextCursor := strconv.Itoa(end)
+		resp.Data = resp.Data[:limit]
+		resp.Pagination = pagination{NextCursor: &nextCursor, HasMore: true}
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func parseLimit(w http.ResponseWriter, r *http.Request, defaultLimit int) (int, bool) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return defaultLimit, true
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid limit")
+		return 0, false
+	}
+
+	return limit, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "Not found")
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]string{"code": code, "message": message},
+	})
+}
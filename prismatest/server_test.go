@@ -0,0 +1,245 @@
+// Copyright (c) Prisma Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package prismatest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/prisma/terraform-provider-prisma-postgres/internal/client"
+)
+
+// newTestClient creates a client configured to use the fake.
+func newTestClient(s *Server) *client.Client {
+	return client.NewClient(client.Config{
+		ServiceToken: "test-token",
+		BaseURL:      s.URL(),
+	})
+}
+
+// TestServerLifecycle verifies that resources created through the fake can be
+// read, listed, and deleted.
+func TestServerLifecycle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	ctx := context.Background()
+	c := newTestClient(s)
+
+	project, err := c.CreateProject(ctx, "test-project", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if project.Workspace == nil || project.Workspace.ID != DefaultWorkspaceID {
+		t.Errorf("expected workspace %q, got %+v", DefaultWorkspaceID, project.Workspace)
+	}
+
+	database, err := c.CreateDatabase(ctx, project.ID, "test-database", "eu-west-3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if database.DirectConnection == nil || database.DirectConnection.Pass != DatabasePassword {
+		t.Errorf("expected direct connection credentials, got %+v", database.DirectConnection)
+	}
+
+	got, err := c.GetDatabase(ctx, database.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Region == nil || got.Region.ID != "eu-west-3" {
+		t.Errorf("expected region 'eu-west-3', got %+v", got.Region)
+	}
+	if got.Project == nil || got.Project.ID != project.ID {
+		t.Errorf("expected project %q, got %+v", project.ID, got.Project)
+	}
+	if got.ConnectionString != "" {
+		t.Errorf("expected no connection string on read, got %q", got.ConnectionString)
+	}
+
+	connection, err := c.CreateConnection(ctx, database.ID, "test-connection")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if connection.Pass != ConnectionPassword {
+		t.Errorf("expected password %q, got %q", ConnectionPassword, connection.Pass)
+	}
+
+	connections, err := c.ListConnections(ctx, database.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(connections) != 1 || connections[0].ID != connection.ID {
+		t.Errorf("expected connection %q, got %+v", connection.ID, connections)
+	}
+
+	// Deleting the project deletes its databases and connections.
+	if err := c.DeleteProject(ctx, project.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = c.GetDatabase(ctx, database.ID)
+	if apiErr, ok := err.(*client.APIError); !ok || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if ids := s.ConnectionIDs(); len(ids) != 0 {
+		t.Errorf("expected no connections, got %v", ids)
+	}
+}
+
+// TestServerRestore verifies that restored databases provision and recover
+// until read.
+func TestServerRestore(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	ctx := context.Background()
+	c := newTestClient(s)
+
+	project, err := c.CreateProject(ctx, "test-project", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := c.CreateDatabase(ctx, project.ID, "source", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := c.RestoreDatabase(ctx, project.ID, "restored", "", source.ID, "bkp_missing"); err == nil {
+		t.Error("expected error for missing backup")
+	}
+
+	restored, err := c.RestoreDatabase(ctx, project.ID, "restored", "", source.ID, BackupID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if restored.Status != StatusProvisioning {
+		t.Errorf("expected status %q, got %q", StatusProvisioning, restored.Status)
+	}
+
+	for _, expected := range []string{StatusRecovering, StatusReady} {
+		got, err := c.GetDatabase(ctx, restored.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Status != expected {
+			t.Errorf("expected status %q, got %q", expected, got.Status)
+		}
+	}
+}
+
+// TestServerPagination verifies that list endpoints page through results.
+func TestServerPagination(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	ctx := context.Background()
+	c := newTestClient(s)
+
+	for i := 0; i < defaultPageSize+5; i++ {
+		if _, err := c.CreateProject(ctx, fmt.Sprintf("project-%d", i), false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	projects, err := c.ListProjects(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(projects) != defaultPageSize+5 {
+		t.Errorf("expected %d projects, got %d", defaultPageSize+5, len(projects))
+	}
+}
+
+// TestServerErrors verifies error responses for invalid requests.
+func TestServerErrors(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		token    string
+		call     func(c *client.Client) error
+		expected int
+	}{
+		{
+			name:  "missing token",
+			token: "",
+			call: func(c *client.Client) error {
+				_, err := c.ListRegions(ctx)
+				return err
+			},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:  "unknown project",
+			token: "test-token",
+			call: func(c *client.Client) error {
+				_, err := c.GetProject(ctx, "proj_missing")
+				return err
+			},
+			expected: http.StatusNotFound,
+		},
+		{
+			name:  "unavailable region",
+			token: "test-token",
+			call: func(c *client.Client) error {
+				project, err := c.CreateProject(ctx, "test-project", false)
+				if err != nil {
+					return err
+				}
+				_, err = c.CreateDatabase(ctx, project.ID, "test-database", "ap-southeast-1")
+				return err
+			},
+			expected: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := client.NewClient(client.Config{ServiceToken: tt.token, BaseURL: s.URL()})
+
+			err := tt.call(c)
+			apiErr, ok := err.(*client.APIError)
+			if !ok {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != tt.expected {
+				t.Errorf("expected status %d, got %d", tt.expected, apiErr.StatusCode)
+			}
+			if apiErr.Code == "" {
+				t.Error("expected error code to be set")
+			}
+		})
+	}
+}
+
+// TestServerHandle verifies that handlers override the fake's routes and
+// that requests are counted.
+func TestServerHandle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	s.Handle("GET", "/v1/regions/postgres", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := newTestClient(s).ListRegions(context.Background())
+	if apiErr, ok := err.(*client.APIError); !ok || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected service unavailable error, got %v", err)
+	}
+
+	if got := s.Requests("GET", "/v1/regions/postgres"); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+
+	s.ResetRequests()
+	if got := s.Requests("GET", "/v1/regions/postgres"); got != 0 {
+		t.Errorf("expected 0 requests after reset, got %d", got)
+	}
+}